@@ -0,0 +1,51 @@
+// Package bench measures the solvers with the testing package's benchmark machinery
+// and keeps a history of the measurements so that runs on different commits can be compared.
+package bench
+
+import (
+	"errors"
+	"testing"
+)
+
+// Result is the cost of solving one part of a day
+type Result struct {
+	Day         int   `json:"day"`
+	Part        int   `json:"part"`
+	Iterations  int   `json:"iterations"`
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+// Measure benchmarks solve on input and returns its cost per call. The part is run once
+// before benchmarking so that a failing part is reported instead of measured.
+func Measure(day, part int, solve func([]string) (any, error), input []string) (Result, error) {
+	if _, err := solve(input); err != nil {
+		return Result{}, err
+	}
+
+	var failure error
+	r := testing.Benchmark(func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			if _, err := solve(input); err != nil && failure == nil {
+				failure = err
+			}
+		}
+	})
+	if failure != nil {
+		return Result{}, failure
+	}
+	if r.N == 0 {
+		return Result{}, errors.New("benchmark did not run")
+	}
+
+	return Result{
+		Day:         day,
+		Part:        part,
+		Iterations:  r.N,
+		NsPerOp:     r.NsPerOp(),
+		AllocsPerOp: r.AllocsPerOp(),
+		BytesPerOp:  r.AllocedBytesPerOp(),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package bench
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMeasure(t *testing.T) {
+	solve := func(input []string) (any, error) {
+		return strings.Join(input, ","), nil
+	}
+	r, err := Measure(3, 2, solve, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Day != 3 || r.Part != 2 || r.Iterations == 0 || r.AllocsPerOp == 0 {
+		t.Errorf("Measure() = %+v, want day 3 part 2 with iterations and allocations", r)
+	}
+
+	failing := errors.New("failing")
+	fail := func([]string) (any, error) { return nil, failing }
+	if _, err := Measure(3, 2, fail, nil); !errors.Is(err, failing) {
+		t.Errorf("Measure() error = %v, want %v", err, failing)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "2024", "bench.json")
+	h, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Add(Run{Label: "abc", Results: []Result{{Day: 1, Part: 1, NsPerOp: 100}}})
+	h.Add(Run{Label: "def", Results: []Result{{Day: 1, Part: 1, NsPerOp: 150}}})
+	h.Add(Run{Label: "def", Results: []Result{{Day: 1, Part: 1, NsPerOp: 90}}})
+	if err := h.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		find   func(string) (Run, bool)
+		label  string
+		wantNs int64
+	}{
+		{name: "Latest", find: reloaded.Latest, label: "", wantNs: 90},
+		{name: "Latest", find: reloaded.Latest, label: "abc", wantNs: 100},
+		{name: "Before", find: reloaded.Before, label: "def", wantNs: 100},
+		{name: "Before", find: reloaded.Before, label: "ghi", wantNs: 90},
+	}
+	for _, tt := range tests {
+		run, ok := tt.find(tt.label)
+		r, found := run.Lookup(1, 1)
+		if !ok || !found || r.NsPerOp != tt.wantNs {
+			t.Errorf("%s(%q) = %+v, want a run taking %d ns/op", tt.name, tt.label, run, tt.wantNs)
+		}
+	}
+	if _, ok := reloaded.Latest("missing"); ok {
+		t.Error(`Latest("missing") found a run`)
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	base := Run{Label: "old", Results: []Result{{Day: 5, Part: 1, NsPerOp: 200, AllocsPerOp: 4, BytesPerOp: 64}}}
+	head := Run{Label: "new", Results: []Result{
+		{Day: 5, Part: 1, NsPerOp: 300, AllocsPerOp: 4, BytesPerOp: 32},
+		{Day: 5, Part: 2, NsPerOp: 50},
+	}}
+
+	var sb strings.Builder
+	if err := WriteTable(&sb, head, &base); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimRight(sb.String(), "\n"), "\n")
+	want := [][]string{
+		{"Day", "Part", "old", "ns/op", "new", "ns/op", "delta", "allocs/op", "B/op"},
+		{"05", "1", "200", "300", "+50.0%", "4", "64", "->", "32"},
+		{"05", "2", "-", "50", "-", "0", "0"},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("WriteTable() printed %d lines, want %d:\n%s", len(lines), len(want), sb.String())
+	}
+	for i, line := range lines {
+		if got := strings.Fields(line); strings.Join(got, " ") != strings.Join(want[i], " ") {
+			t.Errorf("line %d = %q, want fields %q", i, line, want[i])
+		}
+	}
+}
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		old, current int64
+		want         string
+	}{
+		{old: 100, current: 100, want: "+0.0%"},
+		{old: 100, current: 80, want: "-20.0%"},
+		{old: 0, current: 0, want: "~"},
+		{old: 0, current: 5, want: "new"},
+	}
+	for _, tt := range tests {
+		if got := delta(tt.old, tt.current); got != tt.want {
+			t.Errorf("delta(%d, %d) = %q, want %q", tt.old, tt.current, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package bench
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"aoc2024/utility"
+)
+
+// Run is one invocation of the benchmarks, labelled with the commit it measured
+type Run struct {
+	Label     string    `json:"label"`
+	At        time.Time `json:"at"`
+	GoVersion string    `json:"go_version"`
+	Results   []Result  `json:"results"`
+}
+
+// Lookup returns the result for one part of a day, if the run measured it
+func (r Run) Lookup(day, part int) (Result, bool) {
+	for _, res := range r.Results {
+		if res.Day == day && res.Part == part {
+			return res, true
+		}
+	}
+	return Result{}, false
+}
+
+// History is the list of recorded runs, oldest first
+type History struct {
+	path string
+	Runs []Run `json:"runs"`
+}
+
+// HistoryPath returns where the benchmark history is kept inside the inputs directory dir
+func HistoryPath(dir string) string {
+	return filepath.Join(dir, strconv.Itoa(utility.Year), "bench.json")
+}
+
+// LoadHistory reads the history at path. A missing file yields an empty history.
+func LoadHistory(path string) (*History, error) {
+	h := &History{path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return h, nil
+}
+
+// Save writes the history back to the file it was loaded from
+func (h *History) Save() error {
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o750); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, append(data, '\n'), 0o600)
+}
+
+// Add appends a run to the history
+func (h *History) Add(run Run) {
+	h.Runs = append(h.Runs, run)
+}
+
+// Latest returns the most recent run with the given label, or the most recent run
+// of all if label is empty
+func (h *History) Latest(label string) (Run, bool) {
+	for i := len(h.Runs) - 1; i >= 0; i-- {
+		if label == "" || h.Runs[i].Label == label {
+			return h.Runs[i], true
+		}
+	}
+	return Run{}, false
+}
+
+// Before returns the most recent run whose label differs from label, which is the
+// natural baseline for a run of the commit called label
+func (h *History) Before(label string) (Run, bool) {
+	for i := len(h.Runs) - 1; i >= 0; i-- {
+		if h.Runs[i].Label != label {
+			return h.Runs[i], true
+		}
+	}
+	return Run{}, false
+}
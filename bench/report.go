@@ -0,0 +1,54 @@
+package bench
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// WriteTable prints the results of head as a table. If base is not nil its results are
+// printed next to them, with the change in time per operation.
+func WriteTable(w io.Writer, head Run, base *Run) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	if base == nil {
+		fmt.Fprintln(tw, "Day\tPart\tns/op\tallocs/op\tB/op\t")
+	} else {
+		fmt.Fprintf(tw, "Day\tPart\t%s ns/op\t%s ns/op\tdelta\tallocs/op\tB/op\t\n", base.Label, head.Label)
+	}
+
+	for _, r := range head.Results {
+		if base == nil {
+			fmt.Fprintf(tw, "%02d\t%d\t%d\t%d\t%d\t\n", r.Day, r.Part, r.NsPerOp, r.AllocsPerOp, r.BytesPerOp)
+			continue
+		}
+
+		old, ok := base.Lookup(r.Day, r.Part)
+		if !ok {
+			fmt.Fprintf(tw, "%02d\t%d\t-\t%d\t-\t%d\t%d\t\n", r.Day, r.Part, r.NsPerOp, r.AllocsPerOp, r.BytesPerOp)
+			continue
+		}
+		fmt.Fprintf(tw, "%02d\t%d\t%d\t%d\t%s\t%s\t%s\t\n", r.Day, r.Part, old.NsPerOp, r.NsPerOp,
+			delta(old.NsPerOp, r.NsPerOp),
+			change(old.AllocsPerOp, r.AllocsPerOp), change(old.BytesPerOp, r.BytesPerOp))
+	}
+	return tw.Flush()
+}
+
+// delta formats the relative change from old to current as a signed percentage
+func delta(old, current int64) string {
+	if old == 0 {
+		if current == 0 {
+			return "~"
+		}
+		return "new"
+	}
+	return fmt.Sprintf("%+.1f%%", float64(current-old)/float64(old)*100)
+}
+
+// change formats a counter, showing the old value too when it changed
+func change(old, current int64) string {
+	if old == current {
+		return fmt.Sprint(current)
+	}
+	return fmt.Sprintf("%d -> %d", old, current)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"aoc2024/day24"
+	"aoc2024/utility"
+)
+
+// adderCommand parses the arguments of "aoc adder" and reports what is wrong with the
+// day 24 adder and which swaps repair it
+func adderCommand(args []string) error {
+	fs := flag.NewFlagSet("adder", flag.ContinueOnError)
+	inputDir := fs.String("inputs", utility.InputDir(), "directory holding <year>/dayNN.txt puzzle inputs")
+	inputFile := fs.String("input", "", "read the circuit from this file, or from stdin if \"-\"")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errors.New("adder: unexpected arguments")
+	}
+
+	path := *inputFile
+	if path == "" {
+		path = utility.InputPath(*inputDir, 24)
+	}
+	input, err := utility.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return diagnoseAdder(input, os.Stdout)
+}
+
+// diagnoseAdder writes the faults of each bit of the adder in input, then the swaps that
+// repair it
+func diagnoseAdder(input []string, out io.Writer) error {
+	faults, err := day24.Diagnose(input)
+	if err != nil {
+		return err
+	}
+	if len(faults) == 0 {
+		fmt.Fprintln(out, "No faults: the circuit is a ripple-carry adder")
+		return nil
+	}
+
+	fmt.Fprintf(out, "%d faults:\n", len(faults))
+	for i, f := range faults {
+		if i == 0 || f.Bit != faults[i-1].Bit {
+			fmt.Fprintf(out, "Bit %d:\n", f.Bit)
+		}
+		fmt.Fprintf(out, "  %-4s %s\n", f.Gate, f.Reason)
+	}
+
+	swaps, err := day24.Repair(input)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "Swaps that repair it:")
+	for _, s := range swaps {
+		fmt.Fprintf(out, "  %s <-> %s\n", s[0], s[1])
+	}
+	return nil
+}
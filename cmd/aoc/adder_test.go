@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiagnoseAdder(t *testing.T) {
+	// A two bit adder with the sum of bit 1 swapped with the AND gate beside it
+	input := []string{
+		"x00: 0", "x01: 0", "y00: 0", "y01: 0",
+		"",
+		"x00 XOR y00 -> z00",
+		"x00 AND y00 -> c00",
+		"x01 XOR y01 -> p01",
+		"x01 AND y01 -> g01",
+		"p01 XOR c00 -> t01",
+		"p01 AND c00 -> z01",
+		"g01 OR t01 -> z02",
+	}
+	var out strings.Builder
+	if err := diagnoseAdder(input, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	got := out.String()
+	for _, want := range []string{
+		"Bit 1:\n",
+		"  z01  z01 is driven by p01 AND c00, want p01 XOR the carry\n",
+		"Swaps that repair it:\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output does not contain %q:\n%s", want, got)
+		}
+	}
+	if !strings.Contains(got, "z01 <-> t01") && !strings.Contains(got, "t01 <-> z01") {
+		t.Errorf("output does not swap z01 and t01:\n%s", got)
+	}
+}
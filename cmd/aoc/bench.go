@@ -0,0 +1,134 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"aoc2024/bench"
+	"aoc2024/registry"
+	"aoc2024/utility"
+)
+
+// benchCommand parses the arguments of "aoc bench", benchmarks the selected days and
+// prints the results next to an earlier run from the history
+func benchCommand(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	part := fs.Int("part", 0, "benchmark only this part (1 or 2); both parts are benchmarked when unset")
+	inputDir := fs.String("inputs", utility.InputDir(), "directory holding <year>/dayNN.txt puzzle inputs")
+	historyFile := fs.String("history", "", "benchmark history file (default <inputs>/<year>/bench.json)")
+	label := fs.String("label", "", "label for this run (default the current git commit)")
+	base := fs.String("base", "", "compare against the latest run with this label (default the latest other label)")
+	report := fs.Bool("report", false, "print the latest recorded run instead of benchmarking")
+	save := fs.Bool("save", true, "append this run to the history")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *part != 0 && *part != 1 && *part != 2 {
+		return fmt.Errorf("invalid part %d: must be 1 or 2", *part)
+	}
+
+	path := *historyFile
+	if path == "" {
+		path = bench.HistoryPath(*inputDir)
+	}
+	history, err := bench.LoadHistory(path)
+	if err != nil {
+		return err
+	}
+
+	var head bench.Run
+	if *report {
+		var ok bool
+		if head, ok = history.Latest(*label); !ok {
+			return fmt.Errorf("no recorded runs in %s", path)
+		}
+	} else {
+		if fs.NArg() == 0 {
+			return errors.New("bench: no days given")
+		}
+		days, err := parseDays(strings.Join(fs.Args(), ","))
+		if err != nil {
+			return err
+		}
+		if *label == "" {
+			*label = gitLabel()
+		}
+		if head, err = benchDays(days, *part, *inputDir, *label); err != nil {
+			return err
+		}
+	}
+
+	var baseline *bench.Run
+	if run, ok := findBase(history, *base, head.Label); ok {
+		baseline = &run
+	}
+	if err := bench.WriteTable(os.Stdout, head, baseline); err != nil {
+		return err
+	}
+
+	if *report || !*save {
+		return nil
+	}
+	history.Add(head)
+	return history.Save()
+}
+
+// benchDays benchmarks the selected parts of each day. Days without an input and parts that
+// fail are reported and left out of the run.
+func benchDays(days []int, part int, inputDir, label string) (bench.Run, error) {
+	run := bench.Run{Label: label, At: time.Now().UTC(), GoVersion: runtime.Version()}
+	for _, day := range days {
+		s, ok := registry.Lookup(day)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Day %02d: no solver registered\n", day)
+			continue
+		}
+		input, err := utility.ReadDayInput(inputDir, day)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Day %02d: %v\n", day, err)
+			continue
+		}
+
+		for i, solve := range []func([]string) (any, error){s.Part1, s.Part2} {
+			if part != 0 && part != i+1 {
+				continue
+			}
+			result, err := bench.Measure(day, i+1, solve, input)
+			switch {
+			case errors.Is(err, registry.ErrNoPart):
+			case err != nil:
+				fmt.Fprintf(os.Stderr, "Day %02d Part %d: %v\n", day, i+1, err)
+			default:
+				run.Results = append(run.Results, result)
+			}
+		}
+	}
+	if len(run.Results) == 0 {
+		return run, errors.New("nothing was benchmarked")
+	}
+	return run, nil
+}
+
+// findBase picks the run to compare against: the latest run labelled base if given,
+// otherwise the latest run of a different commit than head
+func findBase(history *bench.History, base, head string) (bench.Run, bool) {
+	if base != "" {
+		return history.Latest(base)
+	}
+	return history.Before(head)
+}
+
+// gitLabel describes the checked out commit, marking uncommitted changes
+func gitLabel() string {
+	out, err := exec.Command("git", "describe", "--always", "--dirty").Output()
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(string(out))
+}
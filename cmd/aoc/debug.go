@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"aoc2024/day17"
+	"aoc2024/utility"
+)
+
+const debugHelp = `Commands:
+  s, step [N]      Execute the next N instructions (default 1)
+  c, continue      Run until the next breakpoint or until the program halts
+  r, regs          Show the registers, the instruction pointer and the output so far
+  l, list          Disassemble the program, marking breakpoints and the next instruction
+  b, break ADDR    Stop before the instruction at ADDR
+  d, delete ADDR   Remove the breakpoint at ADDR
+  q, quit          Stop debugging
+`
+
+// debugCommand parses the arguments of "aoc debug" and runs the day 17 program under the
+// debugger
+func debugCommand(args []string) error {
+	fs := flag.NewFlagSet("debug", flag.ContinueOnError)
+	inputDir := fs.String("inputs", utility.InputDir(), "directory holding <year>/dayNN.txt puzzle inputs")
+	inputFile := fs.String("input", "", "read the program from this file, or from stdin if \"-\"")
+	a := fs.Uint64("a", 0, "initial value of register A (default the value in the input)")
+	b := fs.Uint64("b", 0, "initial value of register B (default the value in the input)")
+	c := fs.Uint64("c", 0, "initial value of register C (default the value in the input)")
+	disasm := fs.Bool("disasm", false, "print the disassembled program and exit")
+	trace := fs.Bool("trace", false, "print every instruction as it is executed")
+	breaks := fs.String("break", "", "comma-separated addresses to stop at")
+	step := fs.Bool("step", false, "stop before the first instruction")
+	maxSteps := fs.Int("max-steps", day17.DefaultMaxSteps, "give up after this many instructions (no limit if 0)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errors.New("debug: unexpected arguments")
+	}
+	if *inputFile == utility.Stdin && !*disasm {
+		return errors.New("debug: the program cannot be read from stdin, which takes debugger commands")
+	}
+
+	path := *inputFile
+	if path == "" {
+		path = utility.InputPath(*inputDir, 17)
+	}
+	input, err := utility.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	regs, program, err := day17.Parse(input)
+	if err != nil {
+		return err
+	}
+	if *disasm {
+		fmt.Print(day17.Disassemble(program))
+		return nil
+	}
+
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "a":
+			regs.A = *a
+		case "b":
+			regs.B = *b
+		case "c":
+			regs.C = *c
+		}
+	})
+	computer := day17.NewComputer(program, regs)
+	computer.MaxSteps = *maxSteps
+	for _, field := range strings.Split(*breaks, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		addr, err := strconv.Atoi(field)
+		if err != nil {
+			return fmt.Errorf("invalid breakpoint %q", field)
+		}
+		if err := computer.SetBreakpoint(addr); err != nil {
+			return err
+		}
+	}
+
+	d := &debugger{c: computer, in: bufio.NewScanner(os.Stdin), out: os.Stdout, trace: *trace}
+	if *trace {
+		computer.Trace = func(e day17.Event) { fmt.Fprintln(d.out, e) }
+	}
+	return d.run(*step)
+}
+
+// debugger steps a day 17 computer under the control of commands read from in
+type debugger struct {
+	c     *day17.Computer
+	in    *bufio.Scanner
+	out   io.Writer
+	trace bool
+}
+
+// run executes the program, prompting for commands whenever it stops at a breakpoint,
+// and from the start if paused is set
+func (d *debugger) run(paused bool) error {
+	for !d.c.Halted() {
+		if !paused {
+			halted, err := d.c.Run()
+			if err != nil {
+				return err
+			}
+			if halted {
+				break
+			}
+			fmt.Fprintf(d.out, "Breakpoint at %04d\n", d.c.IP)
+		}
+
+		quit, err := d.prompt()
+		if err != nil || quit {
+			return err
+		}
+		paused = false
+	}
+
+	fmt.Fprintf(d.out, "Halted after %d steps with %s\n", d.c.Steps, d.c.Registers)
+	fmt.Fprintf(d.out, "Output: %s\n", formatOutput(d.c.Output))
+	return nil
+}
+
+// prompt reads and carries out commands until one resumes execution or the program halts.
+// It reports whether the user asked to quit.
+func (d *debugger) prompt() (bool, error) {
+	for !d.c.Halted() {
+		fmt.Fprintf(d.out, "%s  %s\n(debug) ", d.current(), d.c.Registers)
+		if !d.in.Scan() {
+			fmt.Fprintln(d.out)
+			return true, d.in.Err()
+		}
+
+		fields := strings.Fields(d.in.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		arg := -1
+		if len(fields) > 1 {
+			n, err := strconv.Atoi(fields[1])
+			if err != nil || n < 0 {
+				fmt.Fprintf(d.out, "invalid argument %q\n", fields[1])
+				continue
+			}
+			arg = n
+		}
+
+		switch fields[0] {
+		case "s", "step":
+			if err := d.step(max(arg, 1)); err != nil {
+				return false, err
+			}
+		case "c", "continue":
+			return false, nil
+		case "r", "regs":
+			fmt.Fprintf(d.out, "IP=%d %s after %d steps\nOutput: %s\n",
+				d.c.IP, d.c.Registers, d.c.Steps, formatOutput(d.c.Output))
+		case "l", "list":
+			d.list()
+		case "b", "break", "d", "delete":
+			if arg < 0 {
+				fmt.Fprintln(d.out, "missing address")
+			} else if fields[0][0] == 'd' {
+				d.c.ClearBreakpoint(arg)
+			} else if err := d.c.SetBreakpoint(arg); err != nil {
+				fmt.Fprintln(d.out, err)
+			}
+		case "q", "quit":
+			return true, nil
+		case "h", "help":
+			fmt.Fprint(d.out, debugHelp)
+		default:
+			fmt.Fprintf(d.out, "unknown command %q, try help\n", fields[0])
+		}
+	}
+	return false, nil
+}
+
+// step executes up to n instructions, printing each unless they are already traced
+func (d *debugger) step(n int) error {
+	for range n {
+		if d.c.Halted() {
+			return nil
+		}
+		before := d.current()
+		if err := d.c.Step(); err != nil {
+			return err
+		}
+		if !d.trace {
+			fmt.Fprintln(d.out, before)
+		}
+	}
+	return nil
+}
+
+// current returns the instruction at the instruction pointer
+func (d *debugger) current() string {
+	in, ok := d.c.Next()
+	if !ok {
+		return "halted"
+	}
+	return fmt.Sprintf("%04d  %-6s ; %s", in.Addr, in, in.Effect())
+}
+
+// list prints the disassembly, marking the next instruction with > and breakpoints with *
+func (d *debugger) list() {
+	for _, in := range day17.Decode(d.c.Program) {
+		marker := " "
+		if d.c.Breakpoint(in.Addr) {
+			marker = "*"
+		}
+		if in.Addr == d.c.IP {
+			marker += ">"
+		} else {
+			marker += " "
+		}
+		fmt.Fprintf(d.out, "%s %04d  %-6s ; %s\n", marker, in.Addr, in, in.Effect())
+	}
+}
+
+// formatOutput joins output values with commas, as the puzzle expects
+func formatOutput(values []uint64) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.FormatUint(v, 10)
+	}
+	return strings.Join(parts, ",")
+}
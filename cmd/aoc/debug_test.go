@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"aoc2024/day17"
+)
+
+func TestDebugger(t *testing.T) {
+	c := day17.NewComputer([]uint64{0, 1, 5, 4, 3, 0}, day17.Registers{A: 729})
+	if err := c.SetBreakpoint(4); err != nil {
+		t.Fatal(err)
+	}
+	var out strings.Builder
+	d := &debugger{c: c, in: bufio.NewScanner(strings.NewReader("s\nc\nd 4\nc\n")), out: &out}
+	if err := d.run(true); err != nil {
+		t.Fatal(err)
+	}
+
+	got := out.String()
+	for _, want := range []string{
+		"(debug) 0000  adv 1  ; A = A >> 1\n",
+		"Breakpoint at 0004",
+		"Output: 4,6,3,5,6,3,5,2,1,0\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("debugger output does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Count(got, "Breakpoint at") != 1 {
+		t.Errorf("expected a single stop at the deleted breakpoint:\n%s", got)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"aoc2024/site"
+	"aoc2024/utility"
+)
+
+// fetchCommand parses the arguments of "aoc fetch" and downloads the selected inputs
+func fetchCommand(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	inputDir := fs.String("inputs", utility.InputDir(), "directory to store <year>/dayNN.txt puzzle inputs in")
+	force := fs.Bool("force", false, "download inputs again even if they are already cached")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("fetch: no days given")
+	}
+
+	days, err := parseDays(strings.Join(fs.Args(), ","))
+	if err != nil {
+		return err
+	}
+
+	client := site.NewClient(os.Getenv("AOC_SESSION_TOKEN"))
+	cache := site.Cache{Dir: *inputDir}
+	ctx := context.Background()
+
+	failed := 0
+	for _, day := range days {
+		meta, downloaded, err := cache.Fetch(ctx, client, day, *force)
+		switch {
+		case errors.Is(err, site.ErrNoSession) || errors.Is(err, site.ErrBadSession):
+			return err
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "Day %02d: %v\n", day, err)
+			failed++
+		case downloaded:
+			fmt.Printf("Day %02d: downloaded %d bytes\n", day, meta.Bytes)
+		case meta.FetchedAt.IsZero():
+			fmt.Printf("Day %02d: already present\n", day)
+		default:
+			fmt.Printf("Day %02d: cached since %s\n", day, meta.FetchedAt.Local().Format(time.DateTime))
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d days failed", failed, len(days))
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	_ "aoc2024/days"
+)
+
+const usage = `Usage: aoc <command> [arguments]
+
+Commands:
+  run [flags] <days>     Run the solvers for the given days, e.g. 17, 1-25 or 1,3,5-7
+  fetch [flags] <days>   Download puzzle inputs using the AOC_SESSION_TOKEN session cookie
+  submit [flags] <day> <part>
+                         Solve a part and submit the answer, recording the verdict in answers.json
+  bench [flags] <days>   Benchmark each part and compare with an earlier run from the history
+  debug [flags]          Disassemble, trace or step through the day 17 program
+  adder [flags]          Report the faulty gates of each bit of the day 24 adder and the swaps that repair it
+
+Run flags:
+  --part N         Run only part 1 or part 2
+  --inputs DIR     Read inputs from DIR/2024/dayNN.txt (default $AOC_INPUT_DIR or "inputs")
+  --input FILE     Read the input of a single day from FILE, or from stdin if FILE is "-"
+  --record         Record the answers in DIR/2024/answers.json for regression tests
+  --format FORMAT  Print results as text (default), json or csv
+  --workers N      Run up to N days at once (default the number of CPUs)
+  --timeout D      Give up on a part after duration D, e.g. 30s, and report it as timed out
+
+Fetch flags:
+  --inputs DIR     Store inputs in DIR/2024/dayNN.txt (default $AOC_INPUT_DIR or "inputs")
+  --force          Download inputs again even if they are already cached
+
+Submit flags:
+  --inputs DIR     Read inputs and DIR/2024/answers.json from DIR (default $AOC_INPUT_DIR or "inputs")
+  --input FILE     Read the input from FILE, or from stdin if FILE is "-"
+
+Bench flags:
+  --part N         Benchmark only part 1 or part 2
+  --inputs DIR     Read inputs from DIR/2024/dayNN.txt (default $AOC_INPUT_DIR or "inputs")
+  --history FILE   Keep the benchmark history in FILE (default DIR/2024/bench.json)
+  --label NAME     Label this run with NAME (default the output of git describe --always --dirty)
+  --base NAME      Compare with the latest run labelled NAME (default the latest run with another label)
+  --report         Print the latest recorded run (or the one given by --label) without benchmarking
+  --save=false     Do not append this run to the history
+
+Debug flags:
+  --inputs DIR     Read the program from DIR/2024/day17.txt (default $AOC_INPUT_DIR or "inputs")
+  --input FILE     Read the program from FILE
+  --a N, --b N, --c N
+                   Start with register A, B or C set to N instead of the value in the input
+  --disasm         Print the disassembled program and exit
+  --trace          Print every instruction with the registers after it
+  --break ADDRS    Stop before the instructions at the comma-separated addresses and prompt for commands
+  --step           Stop before the first instruction and prompt for commands
+  --max-steps N    Give up after N instructions (default 1048576, no limit if 0)
+
+Adder flags:
+  --inputs DIR     Read the circuit from DIR/2024/day24.txt (default $AOC_INPUT_DIR or "inputs")
+  --input FILE     Read the circuit from FILE, or from stdin if FILE is "-"
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCommand(os.Args[2:])
+	case "fetch":
+		err = fetchCommand(os.Args[2:])
+	case "submit":
+		err = submitCommand(os.Args[2:])
+	case "bench":
+		err = benchCommand(os.Args[2:])
+	case "debug":
+		err = debugCommand(os.Args[2:])
+	case "adder":
+		err = adderCommand(os.Args[2:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+}
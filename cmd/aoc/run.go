@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"aoc2024/runner"
+	"aoc2024/site"
+	"aoc2024/utility"
+)
+
+// runCommand parses the arguments of "aoc run" and runs the selected days
+func runCommand(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	part := fs.Int("part", 0, "run only this part (1 or 2); both parts are run when unset")
+	inputDir := fs.String("inputs", utility.InputDir(), "directory holding <year>/dayNN.txt puzzle inputs")
+	inputFile := fs.String("input", "", "read the input from this file, or from stdin if \"-\" (single day only)")
+	record := fs.Bool("record", false, "record answers in answers.json for regression tests, without marking them correct")
+	formatName := fs.String("format", string(runner.Text), "output format: text, json or csv")
+	workers := fs.Int("workers", runtime.NumCPU(), "number of days to run at once")
+	timeout := fs.Duration("timeout", 0, "give up on a part after this long, e.g. 30s (no limit if 0)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *part != 0 && *part != 1 && *part != 2 {
+		return fmt.Errorf("invalid part %d: must be 1 or 2", *part)
+	}
+	format, err := runner.ParseFormat(*formatName)
+	if err != nil {
+		return err
+	}
+	if *workers < 1 {
+		return fmt.Errorf("invalid worker count %d: must be at least 1", *workers)
+	}
+	if fs.NArg() == 0 {
+		return errors.New("run: no days given")
+	}
+
+	days, err := parseDays(strings.Join(fs.Args(), ","))
+	if err != nil {
+		return err
+	}
+	if *inputFile != "" && len(days) != 1 {
+		return errors.New("run: --input can only be used with a single day")
+	}
+
+	var book *site.AnswerBook
+	if *record {
+		if book, err = site.LoadAnswers(site.AnswersPath(*inputDir)); err != nil {
+			return err
+		}
+	}
+
+	tasks := make([]runner.Task, 0, len(days))
+	for _, day := range days {
+		path := *inputFile
+		if path == "" {
+			path = utility.InputPath(*inputDir, day)
+		}
+		tasks = append(tasks, runner.Task{Day: day, Path: path})
+	}
+
+	out := runner.NewWriter(format, os.Stdout, os.Stderr)
+	opts := runner.Options{Parts: runner.Parts(*part), Workers: *workers, Timeout: *timeout}
+	var summary runner.Summary
+	var writeErr error
+	failedDays := make(map[int]bool)
+	runner.Run(context.Background(), tasks, opts, func(r runner.Result) {
+		summary.Add(r)
+		if err := out.Write(r); err != nil && writeErr == nil {
+			writeErr = err
+		}
+		if r.Err != nil || (book != nil && !recordAnswer(book, r.Day, r.Part, r.Answer)) {
+			failedDays[r.Day] = true
+		}
+	})
+	if writeErr != nil {
+		return writeErr
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	// Keep structured output on stdout parseable
+	summaryOut := os.Stdout
+	if format != runner.Text {
+		summaryOut = os.Stderr
+	}
+	fmt.Fprintln(summaryOut, summary)
+
+	if book != nil {
+		if err := book.Save(); err != nil {
+			return err
+		}
+	}
+	if len(failedDays) > 0 {
+		return fmt.Errorf("%d of %d days failed", len(failedDays), len(days))
+	}
+	return nil
+}
+
+// recordAnswer stores answer as the expected answer for a part. It is not marked correct,
+// as only the website can say that. An answer that differs from one recorded or verified
+// earlier is reported as a failure and left unchanged.
+func recordAnswer(book *site.AnswerBook, day, part int, answer string) bool {
+	if recorded, ok := book.Expected(day, part); ok {
+		if recorded != answer {
+			fmt.Fprintf(os.Stderr, "Day %02d Part %d: answer differs from recorded answer %s\n", day, part, recorded)
+			return false
+		}
+		return true
+	}
+	book.RecordExpected(day, part, answer)
+	return true
+}
+
+// parseDays expands a comma-separated list of days and ranges such as "1,3,5-7"
+// into a sorted, de-duplicated slice of day numbers
+func parseDays(spec string) ([]int, error) {
+	selected := make(map[int]bool)
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(field, "-")
+		first, err := parseDay(from)
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if isRange {
+			if last, err = parseDay(to); err != nil {
+				return nil, err
+			}
+			if last < first {
+				return nil, fmt.Errorf("invalid day range %q", field)
+			}
+		}
+
+		for day := first; day <= last; day++ {
+			selected[day] = true
+		}
+	}
+
+	days := make([]int, 0, len(selected))
+	for day := 1; day <= 25; day++ {
+		if selected[day] {
+			days = append(days, day)
+		}
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("no days in %q", spec)
+	}
+	return days, nil
+}
+
+// parseDay parses a single day number and checks it is between 1 and 25
+func parseDay(s string) (int, error) {
+	day, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid day %q", s)
+	}
+	if day < 1 || day > 25 {
+		return 0, fmt.Errorf("day %d out of range 1-25", day)
+	}
+	return day, nil
+}
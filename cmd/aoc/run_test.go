@@ -0,0 +1,35 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    []int
+		wantErr bool
+	}{
+		{spec: "17", want: []int{17}},
+		{spec: "1-3", want: []int{1, 2, 3}},
+		{spec: "5,1-2,2", want: []int{1, 2, 5}},
+		{spec: "20-25", want: []int{20, 21, 22, 23, 24, 25}},
+		{spec: "1, 3", want: []int{1, 3}},
+		{spec: "0", wantErr: true},
+		{spec: "26", wantErr: true},
+		{spec: "3-1", wantErr: true},
+		{spec: "x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDays(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseDays(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseDays(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"aoc2024/runner"
+	"aoc2024/site"
+	"aoc2024/utility"
+)
+
+// submitCommand parses the arguments of "aoc submit", solves the requested part and posts the answer
+func submitCommand(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	inputDir := fs.String("inputs", utility.InputDir(), "directory holding <year>/dayNN.txt inputs and answers.json")
+	inputFile := fs.String("input", "", "read the input from this file, or from stdin if \"-\"")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return errors.New("submit: expected <day> <part>")
+	}
+
+	day, err := parseDay(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	part, err := strconv.Atoi(fs.Arg(1))
+	if err != nil || (part != 1 && part != 2) {
+		return fmt.Errorf("invalid part %q: must be 1 or 2", fs.Arg(1))
+	}
+
+	path := *inputFile
+	if path == "" {
+		path = utility.InputPath(*inputDir, day)
+	}
+	answer, err := solvePart(day, part, path)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Day %02d Part %d: %s\n", day, part, answer)
+
+	book, err := site.LoadAnswers(site.AnswersPath(*inputDir))
+	if err != nil {
+		return err
+	}
+	client := site.NewClient(os.Getenv("AOC_SESSION_TOKEN"))
+	result, err := site.Submit(context.Background(), client, book, day, part, answer)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Verdict: %s\n", result.Verdict)
+	if result.Wait > 0 {
+		fmt.Printf("Wait %s before submitting again\n", result.Wait)
+	}
+	if result.Verdict != site.Correct {
+		fmt.Println(result.Message)
+		return fmt.Errorf("answer not accepted: %s", result.Verdict)
+	}
+	return nil
+}
+
+// solvePart runs one part of a day's solver on the input at path and returns the answer as text
+func solvePart(day, part int, path string) (string, error) {
+	results := runner.RunDay(context.Background(), day, []int{part}, path, 0)
+	if len(results) == 0 {
+		return "", fmt.Errorf("day %d has no part %d", day, part)
+	}
+	return results[0].Answer, results[0].Err
+}
@@ -0,0 +1,83 @@
+package day01
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility"
+	"aoc2024/utility/container"
+	"sort"
+	"strings"
+)
+
+func splitPuzzleInputToTwoSortedLines(input []string) ([]int, []int, error) {
+	line1 := make([]string, 0)
+	line2 := make([]string, 0)
+
+	for _, line := range input {
+		split := strings.Split(line, "  ")
+		line1 = append(line1, strings.TrimSpace(split[0]))
+		line2 = append(line2, strings.TrimSpace(split[1]))
+	}
+	line1Int, err := utility.SliceOfStringsToInt(line1)
+	if err != nil {
+		return nil, nil, err
+	}
+	line2Int, err := utility.SliceOfStringsToInt(line2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Ints(line1Int)
+	sort.Ints(line2Int)
+	return line1Int, line2Int, nil
+}
+
+func compareAndGenerateSimilarityScore(l1 []int, counts *container.Counter[int]) int {
+	var similarityScore int
+	for i := 0; i < len(l1); i++ {
+		similarityScore += l1[i] * counts.Count(l1[i])
+	}
+	return similarityScore
+}
+
+func part2(input []string) (int, error) {
+	l1, l2, err := splitPuzzleInputToTwoSortedLines(input)
+	if err != nil {
+		return 0, err
+	}
+	return compareAndGenerateSimilarityScore(l1, container.NewCounter(l2...)), nil
+}
+
+func part1(input []string) (int, error) {
+	l1, l2, err := splitPuzzleInputToTwoSortedLines(input)
+	if err != nil {
+		return 0, err
+	}
+
+	var totalDistance int
+	for i := 0; i < len(l1); i++ {
+		distance := l1[i] - l2[i]
+		if distance < 0 {
+			distance *= -1
+		}
+		totalDistance += distance
+	}
+
+	return totalDistance, nil
+}
+
+// Solver solves day 1
+type Solver struct{}
+
+func init() {
+	registry.Register(1, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
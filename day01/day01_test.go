@@ -1,7 +1,8 @@
-package main
+package day01
 
 import (
 	"aoc2024/utility"
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -12,7 +13,10 @@ func TestPart1(t *testing.T) {
 		t.Fatal(err)
 	}
 	want := 11
-	got := part1(input)
+	got, err := part1(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part1() = %v, want %v", got, want)
 	}
@@ -24,8 +28,18 @@ func TestPart2(t *testing.T) {
 		t.Fatal(err)
 	}
 	want := 31
-	got := part2(input)
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part2() = %v, want %v", got, want)
 	}
 }
+
+func TestPart1InvalidInput(t *testing.T) {
+	input := []string{"3   4", "x   3"}
+	if _, err := part1(input); !errors.Is(err, utility.ErrInvalidNumber) {
+		t.Errorf("part1() error = %v, want %v", err, utility.ErrInvalidNumber)
+	}
+}
@@ -1,9 +1,9 @@
-package main
+package day02
 
 import (
-	"log"
 	"strings"
 
+	"aoc2024/registry"
 	"aoc2024/utility"
 )
 
@@ -29,12 +29,15 @@ func isSafe(report []int) bool {
 	return true
 }
 
-func part2(input []string) int {
+func part2(input []string) (int, error) {
 	safeCounter := 0
 
 	for _, line := range input {
 		split := strings.Split(line, " ")
-		splitInt := utility.SliceOfStringsToInt(split)
+		splitInt, err := utility.SliceOfStringsToInt(split)
+		if err != nil {
+			return 0, err
+		}
 
 		if isSafe(splitInt) {
 			safeCounter++
@@ -52,29 +55,40 @@ func part2(input []string) int {
 		}
 	}
 
-	return safeCounter
+	return safeCounter, nil
 }
 
-func part1(input []string) int {
+func part1(input []string) (int, error) {
 	safeCounter := 0
 
 	for _, line := range input {
 		split := strings.Split(line, " ")
-		splitInt := utility.SliceOfStringsToInt(split)
+		splitInt, err := utility.SliceOfStringsToInt(split)
+		if err != nil {
+			return 0, err
+		}
 
 		if isSafe(splitInt) {
 			safeCounter++
 		}
 	}
 
-	return safeCounter
+	return safeCounter, nil
 }
 
-func main() {
-	input, err := utility.ParseTextFile("input")
-	if err != nil {
-		log.Fatal(err)
-	}
-	log.Println(part1(input))
-	log.Println(part2(input))
+// Solver solves day 2
+type Solver struct{}
+
+func init() {
+	registry.Register(2, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
 }
@@ -1,9 +1,8 @@
-package main
+package day03
 
 import (
-	"aoc2024/utility"
+	"aoc2024/registry"
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
 )
@@ -27,7 +26,7 @@ func processMultiplication(match []string) (int, error) {
 	return x * y, nil
 }
 
-func part2(input []string) int {
+func part2(input []string) (int, error) {
 	pattern := `(mul\((\d+),(\d+)\)|do\(\)|don't\(\))`
 	re := regexp.MustCompile(pattern)
 	enabled := true
@@ -42,40 +41,48 @@ func part2(input []string) int {
 				enabled = false
 			default:
 				if match[1] != "" && enabled {
-					if result, err := processMultiplication(match); err != nil {
-						log.Fatal(err)
-					} else {
-						total += result
+					result, err := processMultiplication(match)
+					if err != nil {
+						return 0, err
 					}
+					total += result
 				}
 			}
 		}
 	}
-	return total
+	return total, nil
 }
 
-func part1(input []string) int {
+func part1(input []string) (int, error) {
 	pattern := `mul\((\d+),(\d+)\)`
 	re := regexp.MustCompile(pattern)
 	total := 0
 	for _, line := range input {
 		matches := re.FindAllStringSubmatch(line, -1)
 		for _, match := range matches {
-			if result, err := processMultiplication(match); err != nil {
-				log.Fatal(err)
-			} else {
-				total += result
+			result, err := processMultiplication(match)
+			if err != nil {
+				return 0, err
 			}
+			total += result
 		}
 	}
-	return total
+	return total, nil
 }
 
-func main() {
-	input, err := utility.ParseTextFile("input")
-	if err != nil {
-		log.Fatal(err)
-	}
-	log.Println(part1(input))
-	log.Println(part2(input))
+// Solver solves day 3
+type Solver struct{}
+
+func init() {
+	registry.Register(3, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
 }
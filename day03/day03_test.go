@@ -1,4 +1,4 @@
-package main
+package day03
 
 import (
 	"aoc2024/utility"
@@ -12,7 +12,10 @@ func TestPart1(t *testing.T) {
 		t.Fatal(err)
 	}
 	want := 161
-	got := part1(input)
+	got, err := part1(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part1() = %v, want %v", got, want)
 	}
@@ -22,7 +25,10 @@ func TestPart2(t *testing.T) {
 	input := make([]string, 0)
 	input = append(input, "xmul(2,4)&mul[3,7]!^don't()_mul(5,5)+mul(32,64](mul(11,8)undo()?mul(8,5))")
 	want := 48
-	got := part2(input)
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part2() = %v, want %v", got, want)
 	}
@@ -0,0 +1,116 @@
+package day04
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+)
+
+// Pattern represents a configuration of M and S characters around a center A.
+// The pattern forms an X shape with the specified bytes in each corner position.
+type Pattern struct {
+	topLeft     byte
+	topRight    byte
+	bottomLeft  byte
+	bottomRight byte
+}
+
+// checkPattern verifies if the characters around the center position match
+// the specified pattern configuration.
+//
+// Parameters:
+//   - g: The grid of characters
+//   - center: The center Point to check around (must be 'A')
+//   - pattern: The Pattern configuration to match against
+//
+// Returns:
+//   - bool: true if the pattern matches, false otherwise
+func checkPattern(g *grid.Grid[byte], center geom.Point, pattern Pattern) bool {
+	corner := func(dx, dy int) byte {
+		c, _ := g.Get(center.Add(geom.Point{X: dx, Y: dy}))
+		return c
+	}
+	return corner(-1, -1) == pattern.topLeft &&
+		corner(1, -1) == pattern.topRight &&
+		corner(-1, 1) == pattern.bottomLeft &&
+		corner(1, 1) == pattern.bottomRight
+}
+
+// matchWordInDirection checks if a word matches in the grid starting from a position
+// and moving in a specified direction.
+//
+// Parameters:
+//   - pos: Starting Point in the grid
+//   - dir: Offset to move by for each letter of the word
+//   - word: The word to match
+//   - g: The grid of characters
+//
+// Returns:
+//   - bool: true if the word matches, false otherwise
+func matchWordInDirection(pos, dir geom.Point, word string, g *grid.Grid[byte]) bool {
+	for k := 0; k < len(word); k++ {
+		if c, ok := g.Get(pos); !ok || c != word[k] {
+			return false
+		}
+		pos = pos.Add(dir)
+	}
+	return true
+}
+
+func part2(input []string) (int, error) {
+	g, err := grid.Bytes(input)
+	if err != nil {
+		return 0, err
+	}
+
+	patterns := []Pattern{
+		{'M', 'M', 'S', 'S'},
+		{'S', 'S', 'M', 'M'},
+		{'M', 'S', 'M', 'S'},
+		{'S', 'M', 'S', 'M'},
+	}
+
+	count := 0
+	for _, center := range g.FindAll(func(c byte) bool { return c == 'A' }) {
+		for _, pattern := range patterns {
+			if checkPattern(g, center, pattern) {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+func part1(input []string) (int, error) {
+	g, err := grid.Bytes(input)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for startPos := range g.All() {
+		for _, dir := range geom.Adjacent {
+			if matchWordInDirection(startPos, dir, "XMAS", g) {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// Solver solves day 4
+type Solver struct{}
+
+func init() {
+	registry.Register(4, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
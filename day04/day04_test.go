@@ -1,4 +1,4 @@
-package main
+package day04
 
 import (
 	"aoc2024/utility"
@@ -12,7 +12,10 @@ func TestPart1(t *testing.T) {
 		t.Fatal(err)
 	}
 	want := 18
-	got := part1(input)
+	got, err := part1(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part1() = %v, want %v", got, want)
 	}
@@ -24,7 +27,10 @@ func TestPart2(t *testing.T) {
 		t.Fatal(err)
 	}
 	want := 9
-	got := part2(input)
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part2() = %v, want %v", got, want)
 	}
@@ -1,9 +1,11 @@
-package main
+package day05
 
 import (
+	"aoc2024/registry"
 	"aoc2024/utility"
-	"fmt"
-	"log"
+	"aoc2024/utility/container"
+	"aoc2024/utility/parse"
+	"errors"
 	"strings"
 )
 
@@ -20,77 +22,29 @@ type Node struct {
 	outNodes map[int]bool
 }
 
-// parseRule attempts to parse a single rule from a line of text.
-// Returns the parsed rule and whether parsing was successful.
-func parseRule(line string) (Rule, bool) {
-	parts := strings.Split(strings.TrimSpace(line), "|")
-	if len(parts) != 2 {
-		return Rule{}, false
-	}
+// ErrInvalidFormat is returned when the input is not a rules section followed by an
+// updates section
+var ErrInvalidFormat = errors.New("invalid input format: want rules, a blank line and updates")
 
-	var before, after int
-	if _, err := fmt.Sscanf(parts[0], "%d", &before); err != nil {
-		return Rule{}, false
-	}
-	if _, err := fmt.Sscanf(parts[1], "%d", &after); err != nil {
-		return Rule{}, false
-	}
-
-	return Rule{before, after}, true
-}
+// ErrInvalidRule is returned for a rule not of the form "X|Y"
+var ErrInvalidRule = errors.New("invalid rule: want X|Y")
 
-// parseUpdate attempts to parse a comma-separated list of page numbers.
-// Returns the parsed update slice and whether parsing was successful.
-func parseUpdate(line string) ([]int, bool) {
-	if line = strings.TrimSpace(line); line == "" {
-		return nil, false
+// parseRule parses a single rule of the form "X|Y"
+func parseRule(line string) (Rule, error) {
+	beforeStr, afterStr, found := strings.Cut(strings.TrimSpace(line), "|")
+	if !found {
+		return Rule{}, ErrInvalidRule
 	}
-
-	update := make([]int, 0)
-	for _, numStr := range strings.Split(line, ",") {
-		var num int
-		if _, err := fmt.Sscanf(numStr, "%d", &num); err != nil {
-			continue
-		}
-		update = append(update, num)
-	}
-
-	return update, len(update) > 0
-}
-
-// parseRules processes the rules section of the input.
-// Returns the parsed rules and the index where rules section ends.
-func parseRules(lines []string) ([]Rule, int) {
-	var rules []Rule
-	i := 0
-
-	for ; i < len(lines); i++ {
-		line := strings.TrimSpace(lines[i])
-		if line == "" {
-			i++
-			break
-		}
-
-		if rule, ok := parseRule(line); ok {
-			rules = append(rules, rule)
-		}
+	pages, err := utility.SliceOfStringsToInt([]string{beforeStr, afterStr})
+	if err != nil {
+		return Rule{}, err
 	}
-
-	return rules, i
+	return Rule{pages[0], pages[1]}, nil
 }
 
-// parseUpdates processes the updates section of the input.
-// Returns the parsed updates slice.
-func parseUpdates(lines []string, startIdx int) [][]int {
-	var updates [][]int
-
-	for i := startIdx; i < len(lines); i++ {
-		if update, ok := parseUpdate(lines[i]); ok {
-			updates = append(updates, update)
-		}
-	}
-
-	return updates
+// parseUpdate parses a comma-separated list of page numbers
+func parseUpdate(line string) ([]int, error) {
+	return utility.SliceOfStringsToInt(strings.Split(strings.TrimSpace(line), ","))
 }
 
 // parseInput processes the input file and returns two slices:
@@ -103,10 +57,20 @@ func parseUpdates(lines []string, startIdx int) [][]int {
 // 2. Updates section: comma-separated lists of page numbers to be ordered
 //
 // Sections are separated by an empty line.
-func parseInput(lines []string) ([]Rule, [][]int) {
-	rules, updateStartIdx := parseRules(lines)
-	updates := parseUpdates(lines, updateStartIdx)
-	return rules, updates
+func parseInput(lines []string) ([]Rule, [][]int, error) {
+	sections := parse.Sections(lines)
+	if len(sections) != 2 {
+		return nil, nil, ErrInvalidFormat
+	}
+	rules, err := parse.Records(sections[0], parseRule)
+	if err != nil {
+		return nil, nil, err
+	}
+	updates, err := parse.Records(sections[1], parseUpdate)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rules, updates, nil
 }
 
 // isValidOrder checks if a sequence of pages satisfies all applicable ordering rules.
@@ -185,23 +149,22 @@ func buildGraph(update []int, rules []Rule) map[int]*Node {
 func topologicalSort(pages []int, rules []Rule) []int {
 	graph := buildGraph(pages, rules)
 	var result []int
-	var queue []int
+	var queue container.Deque[int]
 
 	for page, node := range graph {
 		if node.inDegree == 0 {
-			queue = append(queue, page)
+			queue.PushBack(page)
 		}
 	}
 
-	for len(queue) > 0 {
-		page := queue[0]
-		queue = queue[1:]
+	for queue.Len() > 0 {
+		page, _ := queue.PopFront()
 		result = append(result, page)
 
 		for nextPage := range graph[page].outNodes {
 			graph[nextPage].inDegree--
 			if graph[nextPage].inDegree == 0 {
-				queue = append(queue, nextPage)
+				queue.PushBack(nextPage)
 			}
 		}
 	}
@@ -209,8 +172,11 @@ func topologicalSort(pages []int, rules []Rule) []int {
 	return result
 }
 
-func part2(input []string) int {
-	rules, updates := parseInput(input)
+func part2(input []string) (int, error) {
+	rules, updates, err := parseInput(input)
+	if err != nil {
+		return 0, err
+	}
 	sum := 0
 
 	for _, update := range updates {
@@ -219,11 +185,14 @@ func part2(input []string) int {
 			sum += getMiddlePage(correctOrder)
 		}
 	}
-	return sum
+	return sum, nil
 }
 
-func part1(input []string) int {
-	rules, updates := parseInput(input)
+func part1(input []string) (int, error) {
+	rules, updates, err := parseInput(input)
+	if err != nil {
+		return 0, err
+	}
 	sum := 0
 
 	for _, update := range updates {
@@ -233,14 +202,22 @@ func part1(input []string) int {
 		}
 	}
 
-	return sum
+	return sum, nil
 }
 
-func main() {
-	input, err := utility.ParseTextFile("input")
-	if err != nil {
-		log.Fatal(err)
-	}
-	log.Println(part1(input))
-	log.Println(part2(input))
+// Solver solves day 5
+type Solver struct{}
+
+func init() {
+	registry.Register(5, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
 }
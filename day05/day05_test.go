@@ -0,0 +1,56 @@
+package day05
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 143
+	got, err := part1(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("part1() = %v, want %v", got, want)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 123
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("part2() = %v, want %v", got, want)
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  error
+	}{
+		{name: "no updates", input: []string{"47|53"}, want: ErrInvalidFormat},
+		{name: "bad rule", input: []string{"47-53", "", "47,53"}, want: ErrInvalidRule},
+		{name: "bad page", input: []string{"47|53", "", "47,5x3"}, want: utility.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		if _, err := part1(tt.input); !errors.Is(err, tt.want) {
+			t.Errorf("%s: part1() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package day06
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"errors"
+)
+
+// ErrNoGuard is returned when the map has no guard ('^')
+var ErrNoGuard = errors.New("no guard on the map")
+
+// getPath simulates the guard's movement and returns a slice of all positions visited
+// The guard follows these rules:
+// - If there's an obstacle ahead, turn right
+// - Otherwise, move forward
+// - Stop when reaching the grid boundary
+func getPath(g *grid.Grid[byte], start geom.Point) []geom.Point {
+	visited := make([]bool, g.Size())
+	var path []geom.Point
+
+	pos := start
+	dir := geom.North
+
+	for {
+		idx := g.Index(pos)
+		if !visited[idx] {
+			visited[idx] = true
+			path = append(path, pos)
+		}
+
+		nextPos := pos.Add(dir.Delta())
+		next, ok := g.Get(nextPos)
+		if !ok {
+			return path
+		}
+
+		if next == '#' {
+			dir = dir.TurnRight()
+		} else {
+			pos = nextPos
+		}
+	}
+}
+
+// countLoopPositions counts how many positions, when blocked, would cause the guard
+// to enter an infinite loop. Tests each position in the initial path by temporarily
+// placing an obstacle and checking for a loop.
+func countLoopPositions(g *grid.Grid[byte], start geom.Point, initialPath []geom.Point) int {
+	count := 0
+	for _, pos := range initialPath {
+		if g.At(pos) == '.' {
+			g.Set(pos, '#')
+			if hasLoop(g, start) {
+				count++
+			}
+			g.Set(pos, '.')
+		}
+	}
+	return count
+}
+
+// hasLoop checks if the guard's path contains a loop by tracking visited positions
+// and their entry direction using bit flags. Returns true if the same position
+// is visited in the same direction twice.
+func hasLoop(g *grid.Grid[byte], start geom.Point) bool {
+	visited := make([]uint8, g.Size())
+	pos := start
+	dir := geom.North
+
+	for {
+		idx := g.Index(pos)
+		dirBit := uint8(1 << dir)
+
+		if visited[idx]&dirBit != 0 {
+			return true
+		}
+		visited[idx] |= dirBit
+
+		nextPos := pos.Add(dir.Delta())
+		next, ok := g.Get(nextPos)
+		if !ok {
+			return false
+		}
+
+		if next == '#' {
+			dir = dir.TurnRight()
+		} else {
+			pos = nextPos
+		}
+	}
+}
+
+// solve is a helper function that handles the common setup for both parts:
+// converting input to a grid, finding the start position,
+// and running the provided solver function
+func solve(input []string, solver func(g *grid.Grid[byte], startPos geom.Point) int) (int, error) {
+	g, err := grid.Bytes(input)
+	if err != nil {
+		return 0, err
+	}
+	startPos, ok := g.Find(func(c byte) bool { return c == '^' })
+	if !ok {
+		return 0, ErrNoGuard
+	}
+	return solver(g, startPos), nil
+}
+
+func part1(input []string) (int, error) {
+	return solve(input, func(g *grid.Grid[byte], startPos geom.Point) int {
+		return len(getPath(g, startPos))
+	})
+}
+
+func part2(input []string) (int, error) {
+	return solve(input, func(g *grid.Grid[byte], startPos geom.Point) int {
+		return countLoopPositions(g, startPos, getPath(g, startPos))
+	})
+}
+
+// Solver solves day 6
+type Solver struct{}
+
+func init() {
+	registry.Register(6, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
@@ -0,0 +1,133 @@
+package day07
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidEquation is returned for lines not of the form "target: n1 n2 ..."
+var ErrInvalidEquation = errors.New("invalid equation: want target: n1 n2 ...")
+
+// equation is a calibration target and the numbers that may combine to it
+type equation struct {
+	target  int
+	numbers []int
+}
+
+// parseLine parses a single line into target value and numbers
+func parseLine(line string) (equation, error) {
+	targetStr, numbersStr, found := strings.Cut(line, ":")
+	if !found {
+		return equation{}, ErrInvalidEquation
+	}
+	target, err := strconv.Atoi(strings.TrimSpace(targetStr))
+	if err != nil {
+		return equation{}, fmt.Errorf("%w: %q", utility.ErrInvalidNumber, targetStr)
+	}
+	numbers, err := utility.SliceOfStringsToInt(strings.Fields(numbersStr))
+	if err != nil {
+		return equation{}, err
+	}
+	if len(numbers) == 0 {
+		return equation{}, ErrInvalidEquation
+	}
+	return equation{target, numbers}, nil
+}
+
+// calibrate sums the targets of the equations that can be made true
+func calibrate(input []string, allowConcat bool) (int, error) {
+	equations, err := parse.Records(parse.Input(input), parseLine)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, eq := range equations {
+		if isValid(eq.target, eq.numbers, allowConcat, growing(eq.numbers)) {
+			if total, err = utility.AddChecked(total, eq.target); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return total, nil
+}
+
+// growing reports whether every number is positive, so that no operator can make the
+// running value smaller
+func growing(numbers []int) bool {
+	for _, n := range numbers {
+		if n < 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// concat joins the digits of a and b, so concat(12, 345) is 12345
+func concat(a, b int) (int, error) {
+	shifted := a
+	for rest := b; ; rest /= 10 {
+		var err error
+		if shifted, err = utility.MulChecked(shifted, 10); err != nil {
+			return 0, err
+		}
+		if rest < 10 {
+			break
+		}
+	}
+	return utility.AddChecked(shifted, b)
+}
+
+// isValid recursively checks if it's possible to reach the target. A branch whose value
+// overflows cannot reach a target that fits in an int, so it is ruled out like one that
+// does not match. When the numbers are growing, so is a branch passing the target.
+func isValid(target int, values []int, allowConcat, growing bool) bool {
+	if len(values) == 1 {
+		return values[0] == target
+	}
+
+	operators := []func(int, int) (int, error){utility.AddChecked[int], utility.MulChecked[int]}
+	if allowConcat {
+		operators = append(operators, concat)
+	}
+	for _, op := range operators {
+		v, err := op(values[0], values[1])
+		if err != nil || (growing && v > target) {
+			continue
+		}
+		if isValid(target, append([]int{v}, values[2:]...), allowConcat, growing) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func part1(input []string) (int, error) {
+	return calibrate(input, false)
+}
+
+func part2(input []string) (int, error) {
+	return calibrate(input, true)
+}
+
+// Solver solves day 7
+type Solver struct{}
+
+func init() {
+	registry.Register(7, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
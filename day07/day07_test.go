@@ -0,0 +1,95 @@
+package day07
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 3749
+	got, err := part1(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("part1() = %v, want %v", got, want)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 11387
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("part2() = %v, want %v", got, want)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	tests := []struct {
+		a, b int
+		want int
+	}{
+		{a: 12, b: 345, want: 12345},
+		{a: 15, b: 6, want: 156},
+		{a: 7, b: 0, want: 70},
+		{a: 0, b: 10, want: 10},
+	}
+
+	for _, tt := range tests {
+		if got, err := concat(tt.a, tt.b); err != nil || got != tt.want {
+			t.Errorf("concat(%d, %d) = %d, %v, want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+	if _, err := concat(1<<40, 1<<40); !errors.Is(err, utility.ErrOverflow) {
+		t.Errorf("concat() error = %v, want %v", err, utility.ErrOverflow)
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		line string
+		want error
+	}{
+		{line: "190 10 19", want: ErrInvalidEquation},
+		{line: "190:", want: ErrInvalidEquation},
+		{line: "x: 10 19", want: utility.ErrInvalidNumber},
+		{line: "190: 10 1x9", want: utility.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		if _, err := part2([]string{tt.line}); !errors.Is(err, tt.want) {
+			t.Errorf("part2(%q) error = %v, want %v", tt.line, err, tt.want)
+		}
+	}
+}
+
+func TestOverflowingBranches(t *testing.T) {
+	tests := []struct {
+		line string
+		want int
+	}{
+		{line: "190: 4294967296 4294967296 0", want: 0},
+		{line: "3267: 81 40 27 12 34 56 78 91 23 45 67", want: 0},
+		{line: "9223372036854775807: 9223372036854775807 1", want: 9223372036854775807},
+		{line: "1012: 1 0 1 2", want: 1012},
+	}
+
+	for _, tt := range tests {
+		if got, err := part2([]string{tt.line}); err != nil || got != tt.want {
+			t.Errorf("part2(%q) = %d, %v, want %d", tt.line, got, err, tt.want)
+		}
+	}
+}
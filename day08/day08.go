@@ -0,0 +1,103 @@
+package day08
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/container"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"aoc2024/utility/nt"
+)
+
+// buildFrequencyMap constructs a map of frequencies to antenna positions
+func buildFrequencyMap(area *grid.Grid[byte]) map[byte][]geom.Point {
+	freqToAntennas := make(map[byte][]geom.Point)
+	for p, char := range area.All() {
+		if char != '.' {
+			freqToAntennas[char] = append(freqToAntennas[char], p)
+		}
+	}
+	return freqToAntennas
+}
+
+// addAntinodes adds calculated antinodes to the antinodes map based on the bounds and delta
+func addAntinodes(antinodes container.Set[geom.Point], start, delta geom.Point, area *grid.Grid[byte]) {
+	for nextPoint := start.Add(delta); area.InBounds(nextPoint); nextPoint = nextPoint.Add(delta) {
+		antinodes.Add(nextPoint)
+	}
+}
+
+// processAntennas marks every point in line with a pair of antennas. The step between
+// the pair is divided by the gcd of its components, so points lying between whole
+// multiples of their spacing are found as well.
+func processAntennas(antinodes container.Set[geom.Point], antennas []geom.Point, area *grid.Grid[byte]) {
+	for i := 0; i < len(antennas); i++ {
+		for j := i + 1; j < len(antennas); j++ {
+			delta := antennas[j].Sub(antennas[i])
+			g := nt.GCD(delta.X, delta.Y)
+			step := geom.Point{X: delta.X / g, Y: delta.Y / g}
+
+			// Add antinodes in both directions, starting with the antenna itself
+			antinodes.Add(antennas[i])
+			addAntinodes(antinodes, antennas[i], step.Neg(), area)
+			addAntinodes(antinodes, antennas[i], step, area)
+		}
+	}
+}
+
+func part1(input []string) (int, error) {
+	area, err := grid.Bytes(input)
+	if err != nil {
+		return 0, err
+	}
+	freqToAntennas := buildFrequencyMap(area)
+	antinodes := container.NewSet[geom.Point]()
+
+	for _, antennas := range freqToAntennas {
+		for i := 0; i < len(antennas); i++ {
+			for j := i + 1; j < len(antennas); j++ {
+				delta := antennas[j].Sub(antennas[i])
+				an1 := antennas[i].Sub(delta)
+				an2 := antennas[j].Add(delta)
+
+				if area.InBounds(an1) {
+					antinodes.Add(an1)
+				}
+				if area.InBounds(an2) {
+					antinodes.Add(an2)
+				}
+			}
+		}
+	}
+	return antinodes.Len(), nil
+}
+
+func part2(input []string) (int, error) {
+	area, err := grid.Bytes(input)
+	if err != nil {
+		return 0, err
+	}
+	freqToAntennas := buildFrequencyMap(area)
+	antinodes := container.NewSet[geom.Point]()
+
+	for _, antennas := range freqToAntennas {
+		processAntennas(antinodes, antennas, area)
+	}
+	return antinodes.Len(), nil
+}
+
+// Solver solves day 8
+type Solver struct{}
+
+func init() {
+	registry.Register(8, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
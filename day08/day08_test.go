@@ -1,4 +1,4 @@
-package main
+package day08
 
 import (
 	"aoc2024/utility"
@@ -12,7 +12,10 @@ func TestPart1(t *testing.T) {
 		t.Fatal(err)
 	}
 	want := 14
-	got := part1(input)
+	got, err := part1(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part1() = %v, want %v", got, want)
 	}
@@ -24,8 +27,29 @@ func TestPart2(t *testing.T) {
 		t.Fatal(err)
 	}
 	want := 34
-	got := part2(input)
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("part2() = %v, want %v", got, want)
 	}
 }
+
+func TestPart2SharedFactor(t *testing.T) {
+	// The antennas are two diagonal steps apart, so the point between them counts too
+	input := []string{
+		"a....",
+		".....",
+		"..a..",
+		".....",
+		".....",
+	}
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 5 {
+		t.Errorf("part2() = %v, want 5", got)
+	}
+}
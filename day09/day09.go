@@ -1,11 +1,16 @@
-package main
+package day09
 
 import (
-	"aoc2024/utility"
-	"log"
+	"aoc2024/registry"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
 	"strconv"
 )
 
+// ErrInvalidFormat is returned when the input is not a single line of digits
+var ErrInvalidFormat = errors.New("invalid disk map: want one line of digits")
+
 // File represents a file on the disk with its metadata
 type File struct {
 	id     int
@@ -54,6 +59,26 @@ func parseInput(input string) ([]File, int) {
 	return files, totalLength
 }
 
+// parseDiskMap returns the disk map on the only line of the input, checking that it is
+// all digits
+func parseDiskMap(input []string) (string, error) {
+	lines, err := parse.Records(parse.Input(input), func(line string) (string, error) {
+		for _, c := range line {
+			if c < '0' || c > '9' {
+				return "", fmt.Errorf("%w: %q is not a digit", ErrInvalidFormat, c)
+			}
+		}
+		return line, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(lines) != 1 {
+		return "", fmt.Errorf("%w: found %d lines", ErrInvalidFormat, len(lines))
+	}
+	return lines[0], nil
+}
+
 // initialiseDisk sets up the initial state of the disk array based on
 // the provided files. -1 represents free space.
 func initialiseDisk(disk []int, files []File) {
@@ -213,11 +238,27 @@ func part2(input string) int {
 	return calculateChecksum(disk)
 }
 
-func main() {
-	input, err := utility.ParseTextFile("input")
+// Solver solves day 9
+type Solver struct{}
+
+func init() {
+	registry.Register(9, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	diskMap, err := parseDiskMap(input)
+	if err != nil {
+		return nil, err
+	}
+	return part1(diskMap), nil
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	diskMap, err := parseDiskMap(input)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	log.Println(part1(input[0]))
-	log.Println(part2(input[0]))
+	return part2(diskMap), nil
 }
@@ -0,0 +1,52 @@
+package day09
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 1928
+	got := part1(input[0])
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("part1() = %v, want %v", got, want)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 2858
+	got := part2(input[0])
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("part2() = %v, want %v", got, want)
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+	}{
+		{name: "empty", input: nil},
+		{name: "blank", input: []string{""}},
+		{name: "not a digit", input: []string{"12x45"}},
+		{name: "two lines", input: []string{"12345", "678"}},
+	}
+
+	for _, tt := range tests {
+		for part, solve := range []func([]string) (any, error){Solver{}.Part1, Solver{}.Part2} {
+			if _, err := solve(tt.input); !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("%s: part %d error = %v, want %v", tt.name, part+1, err, ErrInvalidFormat)
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package day10
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidHeight is returned for map cells that are neither a digit nor '.'
+var ErrInvalidHeight = errors.New("invalid height")
+
+// impassable is the height of '.' cells, which example maps use for unreachable tiles
+const impassable = -1
+
+func parseGrid(lines []string) (*grid.Grid[int], error) {
+	return grid.Parse(lines, func(ch byte) (int, error) {
+		switch {
+		case ch == '.':
+			return impassable, nil
+		case ch >= '0' && ch <= '9':
+			return int(ch - '0'), nil
+		default:
+			return 0, fmt.Errorf("%w: %q", ErrInvalidHeight, ch)
+		}
+	})
+}
+
+func findTrailheads(g *grid.Grid[int]) []geom.Point {
+	return g.FindAll(func(height int) bool { return height == 0 })
+}
+
+func calculateTrailheadScore(g *grid.Grid[int], start geom.Point) int {
+	reachableNines := make(map[geom.Point]bool)
+
+	var dfs func(pos geom.Point, currentHeight int, path map[geom.Point]bool)
+	dfs = func(pos geom.Point, currentHeight int, path map[geom.Point]bool) {
+		if currentHeight == 9 {
+			reachableNines[pos] = true
+			return
+		}
+
+		for newPos, newHeight := range g.Neighbors4(pos) {
+			if newHeight == currentHeight+1 && !path[newPos] {
+				path[newPos] = true
+				dfs(newPos, newHeight, path)
+				delete(path, newPos)
+			}
+		}
+	}
+
+	initialPath := map[geom.Point]bool{start: true}
+	dfs(start, 0, initialPath)
+
+	return len(reachableNines)
+}
+
+func calculateTrailheadRating(g *grid.Grid[int], start geom.Point) int {
+	pathCount := 0
+
+	var dfs func(pos geom.Point, currentHeight int, path map[geom.Point]bool)
+	dfs = func(pos geom.Point, currentHeight int, path map[geom.Point]bool) {
+		if currentHeight == 9 {
+			pathCount++
+			return
+		}
+
+		for newPos, newHeight := range g.Neighbors4(pos) {
+			if newHeight == currentHeight+1 && !path[newPos] {
+				path[newPos] = true
+				dfs(newPos, newHeight, path)
+				delete(path, newPos)
+			}
+		}
+	}
+
+	initialPath := map[geom.Point]bool{start: true}
+	dfs(start, 0, initialPath)
+
+	return pathCount
+}
+
+func part1(input []string) (int, error) {
+	g, err := parseGrid(input)
+	if err != nil {
+		return 0, err
+	}
+	totalScore := 0
+
+	trailheads := findTrailheads(g)
+
+	for _, start := range trailheads {
+		score := calculateTrailheadScore(g, start)
+		totalScore += score
+	}
+
+	return totalScore, nil
+}
+
+func part2(input []string) (int, error) {
+	g, err := parseGrid(input)
+	if err != nil {
+		return 0, err
+	}
+	totalRating := 0
+
+	trailheads := findTrailheads(g)
+	for _, start := range trailheads {
+		rating := calculateTrailheadRating(g, start)
+		totalRating += rating
+	}
+
+	return totalRating, nil
+}
+
+// Solver solves day 10
+type Solver struct{}
+
+func init() {
+	registry.Register(10, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
@@ -0,0 +1,136 @@
+package day11
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility"
+	"aoc2024/utility/memo"
+	"strconv"
+	"strings"
+)
+
+func readData(lines []string) ([]int, error) {
+	var stones []int
+	for _, line := range lines {
+		values, err := utility.SliceOfStringsToInt(strings.Fields(line))
+		if err != nil {
+			return nil, err
+		}
+		stones = append(stones, values...)
+	}
+	return stones, nil
+}
+
+func blink(stones []int) ([]int, error) {
+	var newStones []int
+	for j := 0; j < len(stones); j++ {
+		if stones[j] == 0 {
+			newStones = append(newStones, 1)
+		} else {
+			charStone := strconv.Itoa(stones[j])
+			if len(charStone)%2 != 0 {
+				stone, err := utility.MulChecked(stones[j], 2024)
+				if err != nil {
+					return nil, err
+				}
+				newStones = append(newStones, stone)
+			} else {
+				num1, _ := strconv.Atoi(charStone[:len(charStone)/2])
+				num2, _ := strconv.Atoi(charStone[len(charStone)/2:])
+				newStones = append(newStones, num1, num2)
+			}
+		}
+	}
+	return newStones, nil
+}
+
+// stoneKey is a stone and how many more times to blink at it
+type stoneKey struct {
+	stone, blinks int
+}
+
+// stoneCount is how many stones a stone turns into, or why they could not be counted
+type stoneCount struct {
+	n   int
+	err error
+}
+
+// newStoneCounter returns a memoized count of the stones a single stone turns into.
+// Stones never affect each other and the same numbers keep coming back, so the counts
+// of a few thousand distinct stones cover every blink.
+func newStoneCounter() *memo.Func[stoneKey, stoneCount] {
+	return memo.New(0, func(count func(stoneKey) stoneCount, key stoneKey) stoneCount {
+		if key.blinks == 0 {
+			return stoneCount{n: 1}
+		}
+		next, err := blink([]int{key.stone})
+		if err != nil {
+			return stoneCount{err: err}
+		}
+		total := 0
+		for _, stone := range next {
+			c := count(stoneKey{stone, key.blinks - 1})
+			if c.err != nil {
+				return c
+			}
+			if total, err = utility.AddChecked(total, c.n); err != nil {
+				return stoneCount{err: err}
+			}
+		}
+		return stoneCount{n: total}
+	})
+}
+
+// countStones returns how many stones there are after blinking at stones
+func countStones(stones []int, blinks int) (int, error) {
+	count := newStoneCounter()
+	total := 0
+	for _, stone := range stones {
+		c := count.Call(stoneKey{stone, blinks})
+		if c.err != nil {
+			return 0, c.err
+		}
+		var err error
+		if total, err = utility.AddChecked(total, c.n); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+func part1(lines []string) (int, error) {
+	stones, err := readData(lines)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < 25; i++ {
+		if stones, err = blink(stones); err != nil {
+			return 0, err
+		}
+	}
+	return len(stones), nil
+}
+
+func part2(lines []string) (int, error) {
+	stones, err := readData(lines)
+	if err != nil {
+		return 0, err
+	}
+	return countStones(stones, 75)
+}
+
+// Solver solves day 11
+type Solver struct{}
+
+func init() {
+	registry.Register(11, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
@@ -0,0 +1,74 @@
+package day11
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBlink(t *testing.T) {
+	tests := []struct {
+		blinks int
+		want   int
+	}{
+		{blinks: 1, want: 3},
+		{blinks: 2, want: 4},
+		{blinks: 6, want: 22},
+		{blinks: 25, want: 55312},
+	}
+
+	for _, tt := range tests {
+		stones := []int{125, 17}
+		for i := 0; i < tt.blinks; i++ {
+			var err error
+			if stones, err = blink(stones); err != nil {
+				t.Fatal(err)
+			}
+		}
+		counts, err := countStones([]int{125, 17}, tt.blinks)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stones) != tt.want || counts != tt.want {
+			t.Errorf("%d blinks: blink() = %d stones, countStones() = %d stones, want %d",
+				tt.blinks, len(stones), counts, tt.want)
+		}
+	}
+}
+
+func TestParts(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		solve func([]string) (int, error)
+		want  int
+	}{
+		{name: "part1", solve: part1, want: 55312},
+		{name: "part2", solve: part2, want: 65601038650482},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.solve(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	// 2024 times a 19 digit stone does not fit in an int
+	input := []string{"1000000000000000000"}
+	for name, solve := range map[string]func([]string) (int, error){"part1": part1, "part2": part2} {
+		if _, err := solve(input); !errors.Is(err, utility.ErrOverflow) {
+			t.Errorf("%s() error = %v, want %v", name, err, utility.ErrOverflow)
+		}
+	}
+}
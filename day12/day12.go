@@ -0,0 +1,218 @@
+package day12
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/dsu"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+)
+
+// Region represents a connected region of the same plant type
+type Region struct {
+	points    map[geom.Point]bool
+	plantType byte
+}
+
+// NewRegion creates a new Region with the given plant type
+func NewRegion(plantType byte) *Region {
+	return &Region{
+		points:    make(map[geom.Point]bool),
+		plantType: plantType,
+	}
+}
+
+// getBounds finds the bounds of the region in the garden
+func (r *Region) getBounds(garden *grid.Grid[byte]) (minRow, maxRow, minCol, maxCol int) {
+	rows, cols := garden.Height(), garden.Width()
+	minRow, maxRow = rows, -1
+	minCol, maxCol = cols, -1
+
+	for point := range r.points {
+		if point.Y < minRow {
+			minRow = point.Y
+		}
+		if point.Y > maxRow {
+			maxRow = point.Y
+		}
+		if point.X < minCol {
+			minCol = point.X
+		}
+		if point.X > maxCol {
+			maxCol = point.X
+		}
+	}
+	return
+}
+
+// isValidTransition checks if a point and its below neighbor form a valid region transition
+func (r *Region) isValidTransition(current, below geom.Point, rows int) bool {
+	if below.Y >= rows {
+		return false
+	}
+
+	hasCurrentPoint := r.points[current]
+	hasBelowPoint := r.points[below]
+
+	return !hasCurrentPoint && hasBelowPoint
+}
+
+// countSidesFromOrientation counts sides from current orientation
+func (r *Region) countSidesFromOrientation(garden *grid.Grid[byte], minRow, maxRow, minCol, maxCol int) int {
+	rows := garden.Height()
+	sides := 0
+
+	for row := minRow - 1; row <= maxRow; row++ {
+		inRegion := false
+		for col := minCol - 1; col <= maxCol; col++ {
+			current := geom.Point{X: col, Y: row}
+			below := geom.Point{X: col, Y: row + 1}
+
+			if r.isValidTransition(current, below, rows) {
+				if !inRegion {
+					sides++
+					inRegion = true
+				}
+			} else {
+				inRegion = false
+			}
+		}
+	}
+	return sides
+}
+
+// rotateAndNormalize rotates the region and normalizes coordinates
+func (r *Region) rotateAndNormalize(garden *grid.Grid[byte]) {
+	// Rotate 90 degrees clockwise
+	newPoints := make(map[geom.Point]bool)
+	for point := range r.points {
+		newPoint := geom.Point{
+			X: -point.Y,
+			Y: point.X,
+		}
+		newPoints[newPoint] = true
+	}
+	r.points = newPoints
+
+	// Normalize coordinates
+	minRow, _, minCol, _ := r.getBounds(garden)
+	if minRow < 0 || minCol < 0 {
+		normalized := make(map[geom.Point]bool)
+		for point := range r.points {
+			normalized[geom.Point{
+				X: point.X - minCol,
+				Y: point.Y - minRow,
+			}] = true
+		}
+		r.points = normalized
+	}
+}
+
+// countSides counts the number of distinct sides of the region
+func (r *Region) countSides(garden *grid.Grid[byte]) int {
+	totalSides := 0
+
+	for rotation := 0; rotation < 4; rotation++ {
+		minRow, maxRow, minCol, maxCol := r.getBounds(garden)
+		totalSides += r.countSidesFromOrientation(garden, minRow, maxRow, minCol, maxCol)
+		r.rotateAndNormalize(garden)
+	}
+
+	return totalSides
+}
+
+// calculatePerimeter counts the number of edges that don't connect to the same plant type
+func (r *Region) calculatePerimeter(garden *grid.Grid[byte]) int {
+	perimeter := 0
+
+	for point := range r.points {
+		// Each point contributes 4 to the perimeter initially
+		edges := 4
+
+		// Subtract 1 for each adjacent point of the same type
+		for neighbor, plant := range garden.Neighbors4(point) {
+			if plant == r.plantType {
+				if r.points[neighbor] {
+					edges--
+				}
+			}
+		}
+		perimeter += edges
+	}
+
+	return perimeter
+}
+
+// findRegions labels the garden's regions by joining every plot with the plots of the
+// same plant to its right and below it
+func findRegions(garden *grid.Grid[byte]) []*Region {
+	plots := dsu.New[geom.Point]()
+	for point, plant := range garden.All() {
+		plots.Add(point)
+		for _, d := range [2]geom.Point{{X: 1, Y: 0}, {X: 0, Y: 1}} {
+			if next, ok := garden.Get(point.Add(d)); ok && next == plant {
+				plots.Union(point, point.Add(d))
+			}
+		}
+	}
+
+	groups := plots.Groups()
+	regions := make([]*Region, len(groups))
+	for i, group := range groups {
+		regions[i] = NewRegion(garden.At(group[0]))
+		for _, point := range group {
+			regions[i].points[point] = true
+		}
+	}
+	return regions
+}
+
+func part1(lines []string) (int, error) {
+	garden, err := grid.Bytes(lines)
+	if err != nil {
+		return 0, err
+	}
+
+	totalPrice := 0
+	for _, region := range findRegions(garden) {
+		area := len(region.points)
+		perimeter := region.calculatePerimeter(garden)
+		price := area * perimeter
+		totalPrice += price
+	}
+
+	return totalPrice, nil
+}
+
+func part2(lines []string) (int, error) {
+	garden, err := grid.Bytes(lines)
+	if err != nil {
+		return 0, err
+	}
+
+	totalPrice := 0
+	for _, region := range findRegions(garden) {
+		area := len(region.points)
+		sides := region.countSides(garden)
+		price := area * sides
+		totalPrice += price
+	}
+
+	return totalPrice, nil
+}
+
+// Solver solves day 12
+type Solver struct{}
+
+func init() {
+	registry.Register(12, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
@@ -0,0 +1,59 @@
+package day12
+
+import (
+	"aoc2024/utility"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	tests := []struct {
+		file string
+		want int
+	}{
+		{file: "test", want: 140},
+		{file: "test2", want: 772},
+		{file: "test3", want: 1930},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := part1(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: part1() = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		file string
+		want int
+	}{
+		{file: "test", want: 80},
+		{file: "test2", want: 436},
+		{file: "test3", want: 1206},
+		{file: "test4", want: 236},
+		{file: "test5", want: 368},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := part2(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: part2() = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
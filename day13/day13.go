@@ -0,0 +1,146 @@
+package day13
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility"
+	"aoc2024/utility/nt"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type system struct {
+	a1, a2 int64 // Button A's X and Y movements
+	b1, b2 int64 // Button B's X and Y movements
+	c1, c2 int64 // Prize X and Y coordinates
+}
+
+// ErrInvalidMachine is returned for a claw machine that is not described by a Button A,
+// a Button B and a Prize line
+var ErrInvalidMachine = errors.New("invalid machine: want Button A, Button B and Prize lines")
+
+// machineLines match the three lines describing a machine, in order, capturing the X
+// and Y values of each
+var machineLines = [3]*regexp.Regexp{
+	regexp.MustCompile(`^Button A: X\+(\d+), Y\+(\d+)$`),
+	regexp.MustCompile(`^Button B: X\+(\d+), Y\+(\d+)$`),
+	regexp.MustCompile(`^Prize: X=(\d+), Y=(\d+)$`),
+}
+
+// parseSystem reads a machine's three lines into a system, e.g.
+//
+//	Button A: X+94, Y+34
+//	Button B: X+22, Y+67
+//	Prize: X=8400, Y=5400
+func parseSystem(s parse.Section) (system, error) {
+	if len(s.Lines) != len(machineLines) {
+		return system{}, fmt.Errorf("%w: %d lines at line %d", ErrInvalidMachine, len(s.Lines), s.Start)
+	}
+
+	var values [3][2]int64
+	for i, line := range s.Lines {
+		line = strings.TrimSpace(line)
+		v, err := parse.Match(machineLines[i], line)
+		if errors.Is(err, parse.ErrFormat) {
+			err = ErrInvalidMachine
+		}
+		if err != nil {
+			return system{}, &parse.LineError{Line: s.Start + i, Text: line, Err: err}
+		}
+		values[i] = [2]int64{int64(v[0]), int64(v[1])}
+	}
+	return system{
+		a1: values[0][0], a2: values[0][1],
+		b1: values[1][0], b2: values[1][1],
+		c1: values[2][0], c2: values[2][1],
+	}, nil
+}
+
+// presses returns how many times buttons A and B must be pressed to reach the prize,
+// or 0, 0 when no whole, non-negative number of presses does. The system is solved
+// exactly, so large prizes cannot overflow; an error is only returned if a press count
+// itself does not fit in an int64.
+func (s system) presses() (a, b int64, err error) {
+	solution, err := nt.SolveLinear([][]int64{{s.a1, s.b1}, {s.a2, s.b2}}, []int64{s.c1, s.c2})
+	if errors.Is(err, nt.ErrSingular) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var counts [2]int64
+	for i, v := range solution {
+		if !v.IsInt() || v.Sign() < 0 {
+			return 0, 0, nil
+		}
+		if counts[i], err = utility.BigInt64(v.Num()); err != nil {
+			return 0, 0, err
+		}
+	}
+	return counts[0], counts[1], nil
+}
+
+// partTwoOffset is added to both prize coordinates in part 2
+const partTwoOffset = 10000000000000
+
+// solve sums the tokens needed to win every winnable prize after moving each prize
+// by offset along both axes
+func solve(input []string, offset int64) (int64, error) {
+	var total int64
+
+	for _, section := range parse.Sections(input) {
+		sys, err := parseSystem(section)
+		if err != nil {
+			return 0, err
+		}
+
+		if sys.c1, err = utility.AddChecked(sys.c1, offset); err != nil {
+			return 0, err
+		}
+		if sys.c2, err = utility.AddChecked(sys.c2, offset); err != nil {
+			return 0, err
+		}
+
+		// Calculate and add solution
+		a, b, err := sys.presses()
+		if err != nil {
+			return 0, err
+		}
+		if total, err = addTokens(total, a, b); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// addTokens adds the cost of pressing A a times and B b times to total
+func addTokens(total, a, b int64) (int64, error) {
+	cost, err := utility.MulChecked(3, a)
+	if err != nil {
+		return 0, err
+	}
+	if cost, err = utility.AddChecked(cost, b); err != nil {
+		return 0, err
+	}
+	return utility.AddChecked(total, cost)
+}
+
+// Solver solves day 13
+type Solver struct{}
+
+func init() {
+	registry.Register(13, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return solve(input, 0)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return solve(input, partTwoOffset)
+}
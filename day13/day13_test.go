@@ -0,0 +1,89 @@
+package day13
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		offset int64
+		want   int64
+	}{
+		{name: "part1", offset: 0, want: 480},
+		{name: "part2", offset: partTwoOffset, want: 875318608908},
+	}
+
+	for _, tt := range tests {
+		got, err := solve(input, tt.offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: solve() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInvalidMachine(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  error
+	}{
+		{name: "missing prize", input: []string{"Button A: X+94, Y+34", "Button B: X+22, Y+67"}, want: ErrInvalidMachine},
+		{name: "wrong order", input: []string{"Button B: X+22, Y+67", "Button A: X+94, Y+34", "Prize: X=8400, Y=5400"},
+			want: ErrInvalidMachine},
+		{name: "missing value", input: []string{"Button A: X+94", "Button B: X+22, Y+67", "Prize: X=8400, Y=5400"},
+			want: ErrInvalidMachine},
+		{name: "trailing text", input: []string{"Button A: X+94, Y+34", "Button B: X+22, Y+67", "Prize: X=8400, Y=5400x"},
+			want: ErrInvalidMachine},
+		{name: "extra value", input: []string{"Button A: X+94, Y+34, Z+1", "Button B: X+22, Y+67",
+			"Prize: X=8400, Y=5400"}, want: ErrInvalidMachine},
+		{name: "huge value", input: []string{"Button A: X+94, Y+34", "Button B: X+22, Y+67",
+			"Prize: X=99999999999999999999, Y=5400"}, want: utility.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		if _, err := solve(tt.input, 0); !errors.Is(err, tt.want) {
+			t.Errorf("%s: solve() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestPresses(t *testing.T) {
+	tests := []struct {
+		sys          system
+		wantA, wantB int64
+		wantErr      error
+	}{
+		{sys: system{94, 34, 22, 67, 8400, 5400}, wantA: 80, wantB: 40},
+		{sys: system{26, 66, 67, 21, 12748, 12176}, wantA: 0, wantB: 0},
+		{sys: system{17, 86, 84, 37, 7870, 6450}, wantA: 38, wantB: 86},
+		// The determinant overflows an int64 but the presses do not
+		{sys: system{1 << 40, 0, 0, 1 << 40, 3 << 40, 5 << 40}, wantA: 3, wantB: 5},
+		{sys: system{2, 1, 1, 1, math.MaxInt64, -math.MaxInt64}, wantErr: utility.ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		a, b, err := tt.sys.presses()
+		if a != tt.wantA || b != tt.wantB || !errors.Is(err, tt.wantErr) {
+			t.Errorf("%+v.presses() = %d, %d, %v, want %d, %d, %v",
+				tt.sys, a, b, err, tt.wantA, tt.wantB, tt.wantErr)
+		}
+	}
+
+	input := []string{"Button A: X+1, Y+0", "Button B: X+0, Y+1", "Prize: X=1, Y=1"}
+	if _, err := solve(input, math.MaxInt64); !errors.Is(err, utility.ErrOverflow) {
+		t.Errorf("solve() with a huge offset error = %v, want %v", err, utility.ErrOverflow)
+	}
+}
@@ -0,0 +1,200 @@
+package day14
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/nt"
+	"aoc2024/utility/parse"
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidRobot is returned for lines not of the form "p=x,y v=dx,dy"
+var ErrInvalidRobot = errors.New("invalid robot: want p=x,y v=dx,dy")
+
+// robotFormat matches a whole robot line, capturing its position and velocity
+var robotFormat = regexp.MustCompile(`^p=(-?\d+),(-?\d+) v=(-?\d+),(-?\d+)$`)
+
+// Robot represents a robot with position and velocity vectors
+type Robot struct {
+	pos geom.Point
+	vel geom.Point
+}
+
+// move updates the robot's position based on its velocity, handling wraparound
+// within the given width and height bounds
+func (r *Robot) move(width, height int) {
+	r.pos = r.pos.Add(r.vel).Wrap(width, height)
+}
+
+// parseRobot converts an input line in the format "p=x,y v=dx,dy" into a Robot struct
+// Example input: "p=0,4 v=3,-3"
+func parseRobot(line string) (Robot, error) {
+	v, err := parse.Match(robotFormat, strings.TrimSpace(line))
+	if errors.Is(err, parse.ErrFormat) {
+		return Robot{}, ErrInvalidRobot
+	}
+	if err != nil {
+		return Robot{}, err
+	}
+	return Robot{geom.Point{X: v[0], Y: v[1]}, geom.Point{X: v[2], Y: v[3]}}, nil
+}
+
+// parseRobots converts multiple input lines into a slice of Robots,
+// skipping empty lines
+func parseRobots(input []string) ([]Robot, error) {
+	return parse.Records(parse.Input(input), parseRobot)
+}
+
+// simulateRobots moves all robots for a specified number of steps
+// within the given width and height bounds
+func simulateRobots(robots []Robot, steps, width, height int) {
+	for t := 0; t < steps; t++ {
+		for i := range robots {
+			robots[i].move(width, height)
+		}
+	}
+}
+
+// getQuadrant determines which quadrant a position falls into based on midpoints.
+// Returns row (0/1), column (0/1), and whether the position is valid (not on axes)
+func getQuadrant(pos geom.Point, midX, midY int) (int, int, bool) {
+	if pos.X == midX || pos.Y == midY {
+		return 0, 0, false
+	}
+
+	row := 0
+	if pos.Y > midY {
+		row = 1
+	}
+	col := 0
+	if pos.X >= midX {
+		col = 1
+	}
+
+	return row, col, true
+}
+
+// countQuadrants counts robots in each quadrant and returns their product.
+// Robots on axes are ignored
+func countQuadrants(robots []Robot, width, height int) int {
+	quadrants := [2][2]int{}
+	midX := width / 2
+	midY := height / 2
+
+	for _, robot := range robots {
+		row, col, valid := getQuadrant(robot.pos, midX, midY)
+		if valid {
+			quadrants[row][col]++
+		}
+	}
+
+	product := 1
+	for _, row := range quadrants {
+		for _, count := range row {
+			product *= count
+		}
+	}
+	return product
+}
+
+// calculateMean computes the arithmetic mean of a slice of numbers
+func calculateMean(nums []float64) float64 {
+	var sum float64
+	for _, num := range nums {
+		sum += num
+	}
+	return sum / float64(len(nums))
+}
+
+// variance calculates the variance of a slice of numbers.
+// Returns 0 for empty slices
+func variance(nums []float64) float64 {
+	if len(nums) == 0 {
+		return 0
+	}
+
+	mean := calculateMean(nums)
+	var sum float64
+	for _, num := range nums {
+		diff := num - mean
+		sum += diff * diff
+	}
+	return sum / float64(len(nums))
+}
+
+// tightestTime returns the time in [0, period) at which the coordinate picked by coord
+// is least spread out across the robots. Each coordinate repeats every period steps, the
+// room's size along that axis, so no later time can be tighter.
+func tightestTime(robots []Robot, period int, coord func(Robot, int) int) int {
+	best, bestVariance := 0, math.Inf(1)
+	values := make([]float64, len(robots))
+	for t := range period {
+		for i, r := range robots {
+			values[i] = float64(coord(r, t))
+		}
+		if v := variance(values); v < bestVariance {
+			best, bestVariance = t, v
+		}
+	}
+	return best
+}
+
+// findTreeTime returns when the robots gather into the Christmas tree. The robots
+// cluster in x every width seconds and in y every height seconds, so the tree appears
+// when both cycles line up, which the Chinese Remainder Theorem finds.
+func findTreeTime(robots []Robot, width, height int) (int, error) {
+	tx := tightestTime(robots, width, func(r Robot, t int) int { return nt.Mod(r.pos.X+t*r.vel.X, width) })
+	ty := tightestTime(robots, height, func(r Robot, t int) int { return nt.Mod(r.pos.Y+t*r.vel.Y, height) })
+	t, _, err := nt.CRT([]int{tx, ty}, []int{width, height})
+	return t, err
+}
+
+// Size of the room the robots patrol in the real puzzle
+const (
+	roomWidth  = 101
+	roomHeight = 103
+)
+
+// part1 solves the first part of the puzzle:
+// Simulates robot movement for 100 steps in a width x height room and calculates
+// the product of robots in each quadrant
+func part1(input []string, width, height int) (int, error) {
+	const steps = 100
+
+	robots, err := parseRobots(input)
+	if err != nil {
+		return 0, err
+	}
+	simulateRobots(robots, steps, width, height)
+	return countQuadrants(robots, width, height), nil
+}
+
+// part2 solves the second part of the puzzle:
+// Finds how many steps it takes for robots in a width x height room to form a Christmas tree pattern
+func part2(input []string, width, height int) (int, error) {
+	robots, err := parseRobots(input)
+	if err != nil {
+		return 0, err
+	}
+	return findTreeTime(robots, width, height)
+}
+
+// Solver solves day 14
+type Solver struct{}
+
+func init() {
+	registry.Register(14, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input, roomWidth, roomHeight)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input, roomWidth, roomHeight)
+}
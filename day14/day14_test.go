@@ -0,0 +1,93 @@
+package day14
+
+import (
+	"aoc2024/utility"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		width, height int
+		want          int
+	}{
+		{width: 11, height: 7, want: 12},
+	}
+
+	for _, tt := range tests {
+		got, err := part1(input, tt.width, tt.height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("part1(%dx%d) = %v, want %v", tt.width, tt.height, got, tt.want)
+		}
+	}
+}
+
+// convergingRobots returns robots with distinct velocities that all meet at the
+// centre of the real room after the given number of seconds
+func convergingRobots(seconds int) []string {
+	velocities := [][2]int{
+		{1, 2}, {-3, 5}, {7, -11}, {13, 17}, {-19, -23}, {29, 31}, {-37, 41}, {43, -47},
+		{53, 59}, {-61, 67}, {71, -73}, {79, 83}, {-89, 97}, {5, -7}, {11, 13}, {-17, 19},
+	}
+
+	lines := make([]string, 0, len(velocities))
+	for _, v := range velocities {
+		x := ((50-seconds*v[0])%roomWidth + roomWidth) % roomWidth
+		y := ((51-seconds*v[1])%roomHeight + roomHeight) % roomHeight
+		lines = append(lines, fmt.Sprintf("p=%d,%d v=%d,%d", x, y, v[0], v[1]))
+	}
+	return lines
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		seconds int
+	}{
+		{seconds: 7},
+		{seconds: 500},
+		{seconds: 7000},
+	}
+
+	for _, tt := range tests {
+		got, err := part2(convergingRobots(tt.seconds), roomWidth, roomHeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.seconds {
+			t.Errorf("part2() = %d, want %d", got, tt.seconds)
+		}
+	}
+}
+
+func TestInvalidRobots(t *testing.T) {
+	tests := []struct {
+		line string
+		want error
+	}{
+		{line: "p=0,4 v=3", want: ErrInvalidRobot},
+		{line: "p=0,4 v=3,-3,1", want: ErrInvalidRobot},
+		{line: "0,4 3,-3", want: ErrInvalidRobot},
+		{line: "p=0,4 v=3,-3x", want: ErrInvalidRobot},
+		{line: "p=0,4 junk v=3,-3", want: ErrInvalidRobot},
+		{line: "p=0,4 v=3,99999999999999999999", want: utility.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		_, err := part1([]string{"p=1,1 v=1,1", tt.line}, 11, 7)
+		var lineErr *parse.LineError
+		if !errors.Is(err, tt.want) || !errors.As(err, &lineErr) || lineErr.Line != 2 {
+			t.Errorf("part1(%q) error = %v, want %v on line 2", tt.line, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package day15
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"aoc2024/utility/parse"
+	"errors"
+)
+
+// Board represents the warehouse grid state
+type Board struct {
+	grid  *grid.Grid[byte]
+	robot geom.Point
+}
+
+var (
+	ErrNoRobot       = errors.New("no robot found in input")
+	ErrInvalidFormat = errors.New("invalid input format: want a board, a blank line and moves")
+)
+
+// splitInput returns the section holding the board and the one holding the moves
+func splitInput(input []string) (board, moves parse.Section, err error) {
+	sections := parse.Sections(input)
+	if len(sections) != 2 {
+		return parse.Section{}, parse.Section{}, ErrInvalidFormat
+	}
+	return sections[0], sections[1], nil
+}
+
+// parseMoves reads the arrows of the moves section
+func parseMoves(s parse.Section) ([]geom.Direction, error) {
+	var moves []geom.Direction
+	for i, line := range s.Lines {
+		for j := range len(line) {
+			dir, err := geom.ParseDirection(line[j])
+			if err != nil {
+				return nil, &parse.LineError{Line: s.Start + i, Text: line, Err: err}
+			}
+			moves = append(moves, dir)
+		}
+	}
+	return moves, nil
+}
+
+// ParseInput parses the input lines and returns the board and moves
+func ParseInput(input []string) (*grid.Grid[byte], []geom.Direction, error) {
+	boardSection, movesSection, err := splitInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	board, err := parse.Block(boardSection)
+	if err != nil {
+		return nil, nil, err
+	}
+	moves, err := parseMoves(movesSection)
+	if err != nil {
+		return nil, nil, err
+	}
+	return board, moves, nil
+}
+
+// findRobot locates the robot position in the board
+func findRobot(board *grid.Grid[byte]) (geom.Point, error) {
+	if p, ok := board.Find(func(cell byte) bool { return cell == '@' }); ok {
+		return p, nil
+	}
+	return geom.Point{}, ErrNoRobot
+}
+
+// createExpandedBoard creates a board with doubled width for part 2
+func createExpandedBoard(boardLines []string) (*Board, error) {
+	lines := make([]string, 0, len(boardLines))
+	for _, line := range boardLines {
+		var newLine []byte
+		for _, ch := range line {
+			switch ch {
+			case '#':
+				newLine = append(newLine, '#', '#')
+			case 'O':
+				newLine = append(newLine, '[', ']')
+			case '.':
+				newLine = append(newLine, '.', '.')
+			case '@':
+				newLine = append(newLine, '@', '.')
+			}
+		}
+		lines = append(lines, string(newLine))
+	}
+
+	g, err := grid.Bytes(lines)
+	if err != nil {
+		return nil, err
+	}
+	robot, err := findRobot(g)
+	if err != nil {
+		return nil, err
+	}
+	return &Board{grid: g, robot: robot}, nil
+}
+
+// canMovePart1 checks if movement is possible in part 1
+func canMovePart1(board *grid.Grid[byte], pos, dir geom.Point) bool {
+	next := pos.Add(dir)
+	cell, ok := board.Get(next)
+	if !ok {
+		return false
+	}
+
+	if cell == 'O' {
+		return canMovePart1(board, next, dir)
+	}
+
+	return cell == '.'
+}
+
+// canMovePart2 checks if movement is possible in part 2
+func canMovePart2(board *grid.Grid[byte], pos, dir geom.Point) bool {
+	next := pos.Add(dir)
+	cell, ok := board.Get(next)
+	if !ok {
+		return false
+	}
+
+	switch cell {
+	case ']':
+		if !canMovePart2(board, next, dir) {
+			return false
+		}
+		// Handle vertical movement for wide boxes
+		if dir.Y != 0 {
+			return canMovePart2(board, geom.Point{X: next.X - 1, Y: next.Y}, dir)
+		}
+		return true
+
+	case '[':
+		if !canMovePart2(board, next, dir) {
+			return false
+		}
+		// Handle vertical movement for wide boxes
+		if dir.Y != 0 {
+			return canMovePart2(board, geom.Point{X: next.X + 1, Y: next.Y}, dir)
+		}
+		return true
+	}
+
+	return cell == '.'
+}
+
+// movePart1 executes a move in part 1
+func movePart1(board *grid.Grid[byte], pos, dir geom.Point) geom.Point {
+	if !canMovePart1(board, pos, dir) {
+		return pos
+	}
+
+	next := pos.Add(dir)
+
+	if board.At(next) == 'O' {
+		movePart1(board, next, dir)
+	}
+
+	board.Set(next, board.At(pos))
+	board.Set(pos, '.')
+
+	return next
+}
+
+// movePart2 executes a move in part 2
+func movePart2(board *grid.Grid[byte], pos, dir geom.Point) geom.Point {
+	if !canMovePart2(board, pos, dir) {
+		return pos
+	}
+
+	next := pos.Add(dir)
+
+	switch board.At(next) {
+	case ']':
+		movePart2(board, next, dir)
+		if dir.Y != 0 {
+			movePart2(board, geom.Point{X: next.X - 1, Y: next.Y}, dir)
+		}
+	case '[':
+		movePart2(board, next, dir)
+		if dir.Y != 0 {
+			movePart2(board, geom.Point{X: next.X + 1, Y: next.Y}, dir)
+		}
+	}
+
+	board.Set(next, board.At(pos))
+	board.Set(pos, '.')
+
+	return next
+}
+
+// calculateGPS calculates the sum of GPS coordinates
+func calculateGPS(board *grid.Grid[byte], target byte) int {
+	result := 0
+	for _, p := range board.FindAll(func(cell byte) bool { return cell == target }) {
+		result += p.Y*100 + p.X
+	}
+	return result
+}
+
+func part1(input []string) (int, error) {
+	// Parse input
+	board, moves, err := ParseInput(input)
+	if err != nil {
+		return 0, err
+	}
+
+	// Find robot position
+	robot, err := findRobot(board)
+	if err != nil {
+		return 0, err
+	}
+
+	// Process moves
+	for _, m := range moves {
+		robot = movePart1(board, robot, m.Delta())
+	}
+
+	return calculateGPS(board, 'O'), nil
+}
+
+func part2(input []string) (int, error) {
+	// Find separator
+	boardSection, movesSection, err := splitInput(input)
+	if err != nil {
+		return 0, err
+	}
+
+	// Create expanded board
+	board, err := createExpandedBoard(boardSection.Lines)
+	if err != nil {
+		return 0, err
+	}
+
+	moves, err := parseMoves(movesSection)
+	if err != nil {
+		return 0, err
+	}
+
+	// Process moves
+	for _, m := range moves {
+		board.robot = movePart2(board.grid, board.robot, m.Delta())
+	}
+
+	return calculateGPS(board.grid, '['), nil
+}
+
+// Solver solves day 15
+type Solver struct{}
+
+func init() {
+	registry.Register(15, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input)
+}
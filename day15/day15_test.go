@@ -0,0 +1,78 @@
+package day15
+
+import (
+	"aoc2024/utility"
+	"aoc2024/utility/geom"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	tests := []struct {
+		file string
+		want int
+	}{
+		{file: "test", want: 10092},
+		{file: "test2", want: 2028},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := part1(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: part1() = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		file string
+		want int
+	}{
+		{file: "test", want: 9021},
+		{file: "test3", want: 618},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := part2(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: part2() = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  error
+	}{
+		{name: "no separator", input: []string{"#@.#"}, want: ErrInvalidFormat},
+		{name: "no robot", input: []string{"#..#", "", "<>"}, want: ErrNoRobot},
+		{name: "bad move", input: []string{"#@.#", "", "<x"}, want: geom.ErrInvalidDirection},
+	}
+
+	for _, tt := range tests {
+		if _, err := part1(tt.input); !errors.Is(err, tt.want) {
+			t.Errorf("%s: part1() error = %v, want %v", tt.name, err, tt.want)
+		}
+		if _, err := part2(tt.input); !errors.Is(err, tt.want) {
+			t.Errorf("%s: part2() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
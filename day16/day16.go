@@ -0,0 +1,109 @@
+package day16
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/container"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"aoc2024/utility/search"
+	"errors"
+	"iter"
+)
+
+var (
+	// ErrNoStart is returned when the maze has no start tile ('S')
+	ErrNoStart = errors.New("maze has no start tile")
+	// ErrNoPath is returned when no path leads from the start to an end tile ('E')
+	ErrNoPath = errors.New("end tile cannot be reached")
+)
+
+// State is a reindeer's tile and the way it faces
+type State struct {
+	pos geom.Point
+	dir geom.Direction
+}
+
+// Costs of stepping forward one tile and of turning a quarter in place
+const (
+	stepCost = 1
+	turnCost = 1000
+)
+
+type Maze struct {
+	cells *grid.Grid[byte]
+	start geom.Point
+}
+
+func parseMaze(lines []string) (Maze, error) {
+	cells, err := grid.Bytes(lines)
+	if err != nil {
+		return Maze{}, err
+	}
+	start, ok := cells.Find(func(c byte) bool { return c == 'S' })
+	if !ok {
+		return Maze{}, ErrNoStart
+	}
+	return Maze{cells: cells, start: start}, nil
+}
+
+// moves yields the states reachable from state in one move, with their costs
+func (m Maze) moves(state State) iter.Seq2[State, int] {
+	return func(yield func(State, int) bool) {
+		forward := state.pos.Add(state.dir.Delta())
+		if c, ok := m.cells.Get(forward); ok && c != '#' {
+			if !yield(State{forward, state.dir}, stepCost) {
+				return
+			}
+		}
+		if !yield(State{state.pos, state.dir.TurnRight()}, turnCost) {
+			return
+		}
+		yield(State{state.pos, state.dir.TurnLeft()}, turnCost)
+	}
+}
+
+func (m Maze) isGoal(state State) bool {
+	return m.cells.At(state.pos) == 'E'
+}
+
+// findPath returns the lowest score from the start to the end tile and how many tiles
+// lie on at least one path with that score, or ErrNoPath if the end cannot be reached
+func findPath(maze Maze) (int, int, error) {
+	result := search.Dijkstra(State{maze.start, geom.East}, maze.moves, maze.isGoal)
+	if !result.Found() {
+		return 0, 0, ErrNoPath
+	}
+
+	tiles := container.NewSet[geom.Point]()
+	for state := range result.OnShortestPaths() {
+		tiles.Add(state.pos)
+	}
+	return result.Cost(), tiles.Len(), nil
+}
+
+func solve(lines []string) (int, int, error) {
+	maze, err := parseMaze(lines)
+	if err != nil {
+		return 0, 0, err
+	}
+	return findPath(maze)
+}
+
+// Solver solves day 16
+type Solver struct{}
+
+func init() {
+	registry.Register(16, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	part1, _, err := solve(input)
+	return part1, err
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	_, part2, err := solve(input)
+	return part2, err
+}
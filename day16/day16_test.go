@@ -0,0 +1,43 @@
+package day16
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		file      string
+		wantPart1 int
+		wantPart2 int
+	}{
+		{file: "test", wantPart1: 7036, wantPart2: 45},
+		{file: "test2", wantPart1: 11048, wantPart2: 64},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part1, part2, err := solve(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if part1 != tt.wantPart1 || part2 != tt.wantPart2 {
+			t.Errorf("%s: solve() = %d, %d, want %d, %d", tt.file, part1, part2, tt.wantPart1, tt.wantPart2)
+		}
+	}
+}
+
+func TestUnreachableEnd(t *testing.T) {
+	input := []string{
+		"#####",
+		"#S#E#",
+		"#####",
+	}
+	if _, _, err := solve(input); !errors.Is(err, ErrNoPath) {
+		t.Errorf("solve() error = %v, want %v", err, ErrNoPath)
+	}
+}
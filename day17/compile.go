@@ -0,0 +1,129 @@
+package day17
+
+import (
+	"fmt"
+	"math"
+)
+
+// Slots of the register file. Combo operands 0-6 index it directly, and slot 7 is never
+// read because instructions with the reserved operand 7 fail instead.
+const (
+	slotA = 4
+	slotB = 5
+	slotC = 6
+)
+
+// opInvalid replaces the opcode of instructions that fail when executed
+const opInvalid = CDV + 1
+
+// compiledInstruction is an instruction decoded once, with its operand ready to use
+type compiledInstruction struct {
+	op      Opcode
+	operand uint64 // literal operand
+	slot    uint8  // register file slot of the combo operand
+	target  int    // jump target of jnz
+	err     error  // returned by opInvalid, or by jnz if target is out of range
+}
+
+// Compiled is a program decoded for running many times. Its register file holds the
+// literals 0-3 in front of A, B and C, so a combo operand is read with an index rather
+// than a switch, and runs allocate nothing unless asked for their output.
+type Compiled struct {
+	// MaxSteps stops a run that executes more instructions than this with ErrStepLimit;
+	// 0 means no limit
+	MaxSteps int
+
+	code []compiledInstruction // indexed by address, as jumps may land on odd ones
+}
+
+// Compile decodes program. Invalid instructions only fail when they are executed, as in
+// the interpreter.
+func Compile(program []uint64) *Compiled {
+	p := &Compiled{MaxSteps: DefaultMaxSteps}
+	if len(program) < 2 {
+		return p
+	}
+	p.code = make([]compiledInstruction, len(program)-1)
+	for addr := range p.code {
+		in := Instruction{Addr: addr, Op: Opcode(program[addr]), Operand: program[addr+1]}
+		ci := compiledInstruction{op: in.Op, operand: in.Operand}
+		switch {
+		case in.Op > CDV:
+			ci.op = opInvalid
+			ci.err = fmt.Errorf("instruction at %d: %w: %d", addr, ErrInvalidOpcode, uint64(in.Op))
+		case in.usesCombo() && in.Operand > slotC:
+			ci.op = opInvalid
+			ci.err = fmt.Errorf("instruction at %d: %w: %d", addr, ErrInvalidOperand, in.Operand)
+		case in.usesCombo():
+			ci.slot = uint8(in.Operand)
+		case in.Op == JNZ && in.Operand > math.MaxInt64:
+			ci.err = fmt.Errorf("instruction at %d: %w: %d", addr, ErrJumpOutOfRange, in.Operand)
+		case in.Op == JNZ:
+			ci.target = int(in.Operand)
+		}
+		p.code[addr] = ci
+	}
+	return p
+}
+
+// Output runs the program from regs and returns its output
+func (p *Compiled) Output(regs Registers) ([]uint64, error) {
+	out, _, err := p.run(regs, nil, true)
+	return out, err
+}
+
+// Outputs reports whether running the program from regs outputs exactly want. It stops as
+// soon as the output goes wrong, and does not allocate.
+func (p *Compiled) Outputs(regs Registers, want []uint64) (bool, error) {
+	_, ok, err := p.run(regs, want, false)
+	return ok, err
+}
+
+// run executes the program, checking each output against want when it is not nil and
+// collecting the output if asked to. It returns early with false once the output can no
+// longer match want.
+func (p *Compiled) run(regs Registers, want []uint64, collect bool) ([]uint64, bool, error) {
+	file := [8]uint64{0, 1, 2, 3, regs.A, regs.B, regs.C}
+	var out []uint64
+	n := 0
+	for ip, steps := 0, 0; ip >= 0 && ip < len(p.code); steps++ {
+		if p.MaxSteps > 0 && steps >= p.MaxSteps {
+			return nil, false, fmt.Errorf("%w: %d instructions executed without halting", ErrStepLimit, steps)
+		}
+		in := &p.code[ip]
+		ip += 2
+		switch in.op {
+		case ADV:
+			file[slotA] >>= file[in.slot]
+		case BXL:
+			file[slotB] ^= in.operand
+		case BST:
+			file[slotB] = file[in.slot] & 7
+		case JNZ:
+			if file[slotA] != 0 {
+				if in.err != nil {
+					return nil, false, in.err
+				}
+				ip = in.target
+			}
+		case BXC:
+			file[slotB] ^= file[slotC]
+		case OUT:
+			v := file[in.slot] & 7
+			if want != nil && (n == len(want) || want[n] != v) {
+				return out, false, nil
+			}
+			n++
+			if collect {
+				out = append(out, v)
+			}
+		case BDV:
+			file[slotB] = file[slotA] >> file[in.slot]
+		case CDV:
+			file[slotC] = file[slotA] >> file[in.slot]
+		default:
+			return nil, false, in.err
+		}
+	}
+	return out, want == nil || n == len(want), nil
+}
@@ -0,0 +1,311 @@
+package day17
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrStepLimit  = errors.New("step limit reached")
+	ErrBreakpoint = errors.New("breakpoint address out of range")
+)
+
+// DefaultMaxSteps is the step limit of a new Computer. Puzzle programs halt after a few
+// hundred instructions, so reaching it means the program loops forever.
+const DefaultMaxSteps = 1 << 20
+
+// Opcode is the first word of an instruction
+type Opcode uint64
+
+const (
+	ADV Opcode = iota // A = A >> combo
+	BXL               // B = B ^ literal
+	BST               // B = combo % 8
+	JNZ               // jump to literal if A != 0
+	BXC               // B = B ^ C
+	OUT               // output combo % 8
+	BDV               // B = A >> combo
+	CDV               // C = A >> combo
+)
+
+var mnemonics = [...]string{"adv", "bxl", "bst", "jnz", "bxc", "out", "bdv", "cdv"}
+
+func (op Opcode) String() string {
+	if op < Opcode(len(mnemonics)) {
+		return mnemonics[op]
+	}
+	return fmt.Sprintf("op%d", uint64(op))
+}
+
+// Registers holds the three registers of the computer
+type Registers struct {
+	A, B, C uint64
+}
+
+func (r Registers) String() string {
+	return fmt.Sprintf("A=%d B=%d C=%d", r.A, r.B, r.C)
+}
+
+// combo returns the value of a combo operand: 0-3 are literals and 4-6 are registers A-C
+func (r Registers) combo(operand uint64) (uint64, error) {
+	switch operand {
+	case 0, 1, 2, 3:
+		return operand, nil
+	case 4:
+		return r.A, nil
+	case 5:
+		return r.B, nil
+	case 6:
+		return r.C, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", ErrInvalidOperand, operand)
+	}
+}
+
+// Instruction is an opcode and its operand at an address in a program
+type Instruction struct {
+	Addr    int
+	Op      Opcode
+	Operand uint64
+}
+
+// usesCombo reports whether the operand is a combo operand rather than a literal
+func (in Instruction) usesCombo() bool {
+	switch in.Op {
+	case ADV, BST, OUT, BDV, CDV:
+		return true
+	default:
+		return false
+	}
+}
+
+// operandText returns the operand as written in assembly, naming the register a combo
+// operand reads
+func (in Instruction) operandText() string {
+	if !in.usesCombo() || in.Operand <= 3 {
+		return fmt.Sprint(in.Operand)
+	}
+	if in.Operand <= 6 {
+		return string(rune('A' + in.Operand - 4))
+	}
+	return fmt.Sprintf("?%d", in.Operand)
+}
+
+// String returns the instruction in assembly, e.g. "adv 3", "out B" or "bxc"
+func (in Instruction) String() string {
+	if in.Op == BXC {
+		return in.Op.String()
+	}
+	return in.Op.String() + " " + in.operandText()
+}
+
+// Effect describes what the instruction does, e.g. "B = A % 8"
+func (in Instruction) Effect() string {
+	x := in.operandText()
+	switch in.Op {
+	case ADV:
+		return "A = A >> " + x
+	case BXL:
+		return "B = B ^ " + x
+	case BST:
+		return "B = " + x + " % 8"
+	case JNZ:
+		return "if A != 0 goto " + x
+	case BXC:
+		return "B = B ^ C"
+	case OUT:
+		return "output " + x + " % 8"
+	case BDV:
+		return "B = A >> " + x
+	case CDV:
+		return "C = A >> " + x
+	default:
+		return "invalid opcode"
+	}
+}
+
+// Decode splits a program into its instructions. A trailing opcode without an operand is
+// never executed, so it is left out.
+func Decode(program []uint64) []Instruction {
+	instructions := make([]Instruction, 0, len(program)/2)
+	for addr := 0; addr+1 < len(program); addr += 2 {
+		instructions = append(instructions, Instruction{Addr: addr, Op: Opcode(program[addr]), Operand: program[addr+1]})
+	}
+	return instructions
+}
+
+// Disassemble returns a listing of the program with one instruction per line, giving its
+// address, its assembly and its effect
+func Disassemble(program []uint64) string {
+	var sb strings.Builder
+	for _, in := range Decode(program) {
+		fmt.Fprintf(&sb, "%04d  %-6s ; %s\n", in.Addr, in, in.Effect())
+	}
+	return sb.String()
+}
+
+// Event describes one executed instruction, for tracing
+type Event struct {
+	Step        int // number of instructions executed so far, including this one
+	Instruction Instruction
+	Before      Registers
+	After       Registers
+	// Out is the value written by an out instruction, when Emitted is set
+	Out     uint64
+	Emitted bool
+}
+
+func (e Event) String() string {
+	s := fmt.Sprintf("%6d  %04d  %-6s  %s", e.Step, e.Instruction.Addr, e.Instruction, e.After)
+	if e.Emitted {
+		s += fmt.Sprintf("  -> %d", e.Out)
+	}
+	return s
+}
+
+// Computer runs programs for the 3-bit computer
+type Computer struct {
+	Registers
+	Program []uint64
+	IP      int
+	Output  []uint64
+	// Steps counts the instructions executed
+	Steps int
+	// MaxSteps stops a run that executes more instructions than this with ErrStepLimit;
+	// 0 means no limit
+	MaxSteps int
+	// Trace, if set, is called after every instruction
+	Trace func(Event)
+
+	breakpoints map[int]bool
+	// resuming is set once an instruction has run or Run has stopped at a breakpoint, so
+	// that the next Run executes the instruction at the instruction pointer regardless
+	resuming bool
+}
+
+// NewComputer returns a computer about to run program from the start with the given
+// registers and the default step limit
+func NewComputer(program []uint64, regs Registers) *Computer {
+	return &Computer{Registers: regs, Program: program, MaxSteps: DefaultMaxSteps}
+}
+
+// Halted reports whether the instruction pointer has left the program
+func (c *Computer) Halted() bool {
+	return c.IP < 0 || c.IP+1 >= len(c.Program)
+}
+
+// Next returns the instruction at the instruction pointer, or false if the program has halted
+func (c *Computer) Next() (Instruction, bool) {
+	if c.Halted() {
+		return Instruction{}, false
+	}
+	return Instruction{Addr: c.IP, Op: Opcode(c.Program[c.IP]), Operand: c.Program[c.IP+1]}, true
+}
+
+// SetBreakpoint makes Run stop before executing the instruction at addr
+func (c *Computer) SetBreakpoint(addr int) error {
+	if addr < 0 || addr >= len(c.Program) {
+		return fmt.Errorf("%w: %d", ErrBreakpoint, addr)
+	}
+	if c.breakpoints == nil {
+		c.breakpoints = make(map[int]bool)
+	}
+	c.breakpoints[addr] = true
+	return nil
+}
+
+// ClearBreakpoint removes the breakpoint at addr, if any
+func (c *Computer) ClearBreakpoint(addr int) {
+	delete(c.breakpoints, addr)
+}
+
+// Breakpoint reports whether there is a breakpoint at addr
+func (c *Computer) Breakpoint(addr int) bool {
+	return c.breakpoints[addr]
+}
+
+// Step executes the instruction at the instruction pointer, doing nothing if the program
+// has halted
+func (c *Computer) Step() error {
+	in, ok := c.Next()
+	if !ok {
+		return nil
+	}
+	if c.MaxSteps > 0 && c.Steps >= c.MaxSteps {
+		return fmt.Errorf("%w: %d instructions executed without halting", ErrStepLimit, c.Steps)
+	}
+
+	event := Event{Instruction: in, Before: c.Registers}
+	if err := c.execute(in, &event); err != nil {
+		return fmt.Errorf("instruction at %d: %w", in.Addr, err)
+	}
+	c.Steps++
+	c.resuming = true
+	if c.Trace != nil {
+		event.Step = c.Steps
+		event.After = c.Registers
+		c.Trace(event)
+	}
+	return nil
+}
+
+// execute carries out in, moving the instruction pointer on and recording any output in event
+func (c *Computer) execute(in Instruction, event *Event) error {
+	var x uint64
+	if in.usesCombo() {
+		var err error
+		if x, err = c.combo(in.Operand); err != nil {
+			return err
+		}
+	}
+
+	next := c.IP + 2
+	switch in.Op {
+	case ADV:
+		c.A >>= x
+	case BXL:
+		c.B ^= in.Operand
+	case BST:
+		c.B = x & 7
+	case JNZ:
+		if c.A != 0 {
+			if in.Operand > math.MaxInt64 {
+				return fmt.Errorf("%w: %d", ErrJumpOutOfRange, in.Operand)
+			}
+			next = int(in.Operand)
+		}
+	case BXC:
+		c.B ^= c.C
+	case OUT:
+		event.Out, event.Emitted = x&7, true
+		c.Output = append(c.Output, x&7)
+	case BDV:
+		c.B = c.A >> x
+	case CDV:
+		c.C = c.A >> x
+	default:
+		return fmt.Errorf("%w: %d", ErrInvalidOpcode, uint64(in.Op))
+	}
+	c.IP = next
+	return nil
+}
+
+// Run executes instructions until the program halts or reaches a breakpoint, and reports
+// whether it halted. A breakpoint on the first instruction of a program stops Run before
+// anything runs, but once the computer has stopped, by Run or Step, the instruction at
+// the instruction pointer is executed first, so calling Run again resumes from a
+// breakpoint.
+func (c *Computer) Run() (bool, error) {
+	for first := true; !c.Halted(); first = false {
+		if c.breakpoints[c.IP] && !(first && c.resuming) {
+			c.resuming = true
+			return false, nil
+		}
+		if err := c.Step(); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,110 @@
+package day17
+
+import (
+	"aoc2024/registry"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidFormat  = errors.New("invalid input format: expected registers, a blank line and a program")
+	ErrInvalidOpcode  = errors.New("invalid opcode")
+	ErrInvalidOperand = errors.New("invalid combo operand")
+	ErrJumpOutOfRange = errors.New("jump target too large")
+)
+
+// Parse reads the initial registers and the program from the puzzle input. Every line must
+// hold exactly what the puzzle describes: a register value followed by anything else, or a
+// program word that is not a 3-bit number, is rejected.
+func Parse(input []string) (Registers, []uint64, error) {
+	if len(input) < 5 || strings.TrimSpace(input[3]) != "" {
+		return Registers{}, nil, ErrInvalidFormat
+	}
+	for _, line := range input[5:] {
+		if strings.TrimSpace(line) != "" {
+			return Registers{}, nil, fmt.Errorf("%w: unexpected line %q after the program", ErrInvalidFormat, line)
+		}
+	}
+
+	var regs Registers
+	for i, r := range []*uint64{&regs.A, &regs.B, &regs.C} {
+		name := 'A' + rune(i)
+		value, ok := strings.CutPrefix(strings.TrimSpace(input[i]), "Register "+string(name)+": ")
+		if !ok {
+			return Registers{}, nil, fmt.Errorf("%w: expected register %c on line %d", ErrInvalidFormat, name, i+1)
+		}
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return Registers{}, nil, fmt.Errorf("error parsing register %c from line %q: %w", name, input[i], err)
+		}
+		*r = n
+	}
+
+	programStr, ok := strings.CutPrefix(strings.TrimSpace(input[4]), "Program: ")
+	if !ok {
+		return Registers{}, nil, fmt.Errorf("%w: expected the program on line 5", ErrInvalidFormat)
+	}
+	parts := strings.Split(programStr, ",")
+	program := make([]uint64, len(parts))
+	for i, part := range parts {
+		num, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return Registers{}, nil, fmt.Errorf("error parsing program part %q: %w", part, err)
+		}
+		if num > 7 {
+			return Registers{}, nil, fmt.Errorf("%w: program word %d is not a 3-bit number", ErrInvalidFormat, num)
+		}
+		program[i] = num
+	}
+
+	return regs, program, nil
+}
+
+func partOne(program []uint64, regs Registers) (string, error) {
+	res, err := executeProgram(program, regs)
+	if err != nil {
+		return "", err
+	}
+	// Convert output to comma-separated string
+	strNums := make([]string, len(res))
+	for i, num := range res {
+		strNums[i] = fmt.Sprintf("%d", num)
+	}
+	return strings.Join(strNums, ","), nil
+}
+
+// executeProgram runs program from the given registers and returns its output
+func executeProgram(program []uint64, regs Registers) ([]uint64, error) {
+	c := NewComputer(program, regs)
+	if _, err := c.Run(); err != nil {
+		return nil, err
+	}
+	return c.Output, nil
+}
+
+// Solver solves day 17
+type Solver struct{}
+
+func init() {
+	registry.Register(17, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	regs, program, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return partOne(program, regs)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	regs, program, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return findLowestSelfReplicatingSeed(program, regs)
+}
@@ -0,0 +1,440 @@
+package day17
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	tests := []struct {
+		file string
+		want string
+	}{
+		{file: "test", want: "4,6,3,5,6,3,5,2,1,0"},
+		{file: "test2", want: "5,7,3,0"},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Solver{}.Part1(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: Part1() = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		file string
+		want uint64
+	}{
+		{file: "test2", want: 117440},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Solver{}.Part2(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: Part2() = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	valid := []string{"Register A: 729", "Register B: 0", "Register C: 0", "", "Program: 0,1,5,4,3,0"}
+	regs, program, err := Parse(valid)
+	if err != nil || regs != (Registers{A: 729}) || !slices.Equal(program, []uint64{0, 1, 5, 4, 3, 0}) {
+		t.Errorf("Parse() = %v, %v, %v, want A=729 and [0 1 5 4 3 0]", regs, program, err)
+	}
+
+	tests := []struct {
+		name string
+		line int
+		text string
+	}{
+		{name: "register with trailing text", line: 0, text: "Register A: 3x"},
+		{name: "register missing", line: 1, text: "Register B:"},
+		{name: "wrong register", line: 2, text: "Register A: 0"},
+		{name: "no blank line", line: 3, text: "Register D: 0"},
+		{name: "program word with trailing text", line: 4, text: "Program: 0,1x,5,4,3,0"},
+		{name: "program word out of range", line: 4, text: "Program: 0,8,5,4,3,0"},
+		{name: "negative program word", line: 4, text: "Program: 0,-1,5,4,3,0"},
+		{name: "empty program word", line: 4, text: "Program: 0,,5,4,3,0"},
+	}
+
+	for _, tt := range tests {
+		input := slices.Clone(valid)
+		input[tt.line] = tt.text
+		if _, _, err := Parse(input); err == nil {
+			t.Errorf("%s: Parse() accepted %q", tt.name, tt.text)
+		}
+	}
+	if _, _, err := Parse(append(slices.Clone(valid), "Program: 0")); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Parse() with a second program error = %v, want %v", err, ErrInvalidFormat)
+	}
+}
+
+func TestInvalidProgram(t *testing.T) {
+	tests := []struct {
+		name    string
+		program []uint64
+		want    error
+	}{
+		{name: "reserved combo operand", program: []uint64{5, 7}, want: ErrInvalidOperand},
+		{name: "unknown opcode", program: []uint64{8, 0}, want: ErrInvalidOpcode},
+	}
+
+	for _, tt := range tests {
+		if _, err := executeProgram(tt.program, Registers{}); !errors.Is(err, tt.want) {
+			t.Errorf("%s: executeProgram() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestComputer(t *testing.T) {
+	tests := []struct {
+		program    []uint64
+		regs       Registers
+		wantRegs   Registers
+		wantOutput []uint64
+	}{
+		{program: []uint64{2, 6}, regs: Registers{C: 9}, wantRegs: Registers{B: 1, C: 9}},
+		{
+			program:    []uint64{5, 0, 5, 1, 5, 4},
+			regs:       Registers{A: 10},
+			wantRegs:   Registers{A: 10},
+			wantOutput: []uint64{0, 1, 2},
+		},
+		{
+			program:    []uint64{0, 1, 5, 4, 3, 0},
+			regs:       Registers{A: 2024},
+			wantOutput: []uint64{4, 2, 5, 6, 7, 7, 7, 7, 3, 1, 0},
+		},
+		{program: []uint64{1, 7}, regs: Registers{B: 29}, wantRegs: Registers{B: 26}},
+		{program: []uint64{4, 0}, regs: Registers{B: 2024, C: 43690}, wantRegs: Registers{B: 44354, C: 43690}},
+	}
+
+	for _, tt := range tests {
+		c := NewComputer(tt.program, tt.regs)
+		halted, err := c.Run()
+		if err != nil || !halted {
+			t.Fatalf("%v: Run() = %v, %v", tt.program, halted, err)
+		}
+		if c.Registers != tt.wantRegs || !slices.Equal(c.Output, tt.wantOutput) {
+			t.Errorf("%v from %v: registers %v, output %v, want %v, %v",
+				tt.program, tt.regs, c.Registers, c.Output, tt.wantRegs, tt.wantOutput)
+		}
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+	want := "0000  adv 3  ; A = A >> 3\n" +
+		"0002  out A  ; output A % 8\n" +
+		"0004  jnz 0  ; if A != 0 goto 0\n" +
+		"0006  bxc    ; B = B ^ C\n"
+	if got := Disassemble([]uint64{0, 3, 5, 4, 3, 0, 4, 1, 7}); got != want {
+		t.Errorf("Disassemble() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestBreakpoints(t *testing.T) {
+	c := NewComputer([]uint64{0, 1, 5, 4, 3, 0}, Registers{A: 729})
+	var events []Event
+	c.Trace = func(e Event) { events = append(events, e) }
+	if err := c.SetBreakpoint(4); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetBreakpoint(6); !errors.Is(err, ErrBreakpoint) {
+		t.Errorf("SetBreakpoint(6) error = %v, want %v", err, ErrBreakpoint)
+	}
+
+	stops := 0
+	for {
+		halted, err := c.Run()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if halted {
+			break
+		}
+		if c.IP != 4 {
+			t.Fatalf("stopped at %d, want the breakpoint at 4", c.IP)
+		}
+		stops++
+	}
+
+	// The loop runs once per output, and halts on the jnz after the last one
+	if stops != 10 || len(events) != 30 || c.Steps != 30 {
+		t.Errorf("%d stops, %d events, %d steps, want 10, 30 and 30", stops, len(events), c.Steps)
+	}
+	if e := events[1]; !e.Emitted || e.Out != 4 || e.Before.A != 364 || e.Step != 2 {
+		t.Errorf("second event = %+v, want output 4 with A=364", e)
+	}
+}
+
+func TestBreakpointAtStart(t *testing.T) {
+	c := NewComputer([]uint64{0, 1, 5, 4, 3, 0}, Registers{A: 729})
+	if err := c.SetBreakpoint(0); err != nil {
+		t.Fatal(err)
+	}
+
+	var steps []int
+	for {
+		halted, err := c.Run()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if halted {
+			break
+		}
+		if c.IP != 0 {
+			t.Fatalf("stopped at %d, want the breakpoint at 0", c.IP)
+		}
+		steps = append(steps, c.Steps)
+	}
+
+	// The first stop comes before anything runs, and each later one after an iteration
+	want := []int{0, 3, 6, 9, 12, 15, 18, 21, 24, 27}
+	if !slices.Equal(steps, want) || c.Steps != 30 {
+		t.Errorf("stopped after %v steps and halted after %d, want %v and 30", steps, c.Steps, want)
+	}
+}
+
+func TestStepLimit(t *testing.T) {
+	c := NewComputer([]uint64{3, 0}, Registers{A: 1})
+	c.MaxSteps = 100
+	if _, err := c.Run(); !errors.Is(err, ErrStepLimit) || c.Steps != 100 {
+		t.Errorf("Run() error = %v after %d steps, want %v after 100", err, c.Steps, ErrStepLimit)
+	}
+}
+
+func TestAnalyseShape(t *testing.T) {
+	tests := []struct {
+		name    string
+		program []uint64
+		want    loopShape
+		wantErr error
+	}{
+		{name: "example", program: []uint64{0, 3, 5, 4, 3, 0}, want: loopShape{shift: 3, outputs: 1}},
+		{
+			name:    "puzzle shape",
+			program: []uint64{2, 4, 1, 1, 7, 5, 1, 5, 4, 0, 0, 3, 5, 5, 3, 0},
+			want:    loopShape{shift: 3, outputs: 1},
+		},
+		{name: "two outputs", program: []uint64{0, 1, 5, 4, 5, 4, 3, 0}, want: loopShape{shift: 1, outputs: 2}},
+		{name: "never halts", program: []uint64{5, 4, 3, 0}, wantErr: errShape},
+		{name: "shift by register", program: []uint64{0, 4, 5, 4, 3, 0}, wantErr: errShape},
+		{name: "B carried over", program: []uint64{1, 1, 0, 3, 5, 5, 3, 0}, wantErr: errShape},
+		{name: "jump into the loop", program: []uint64{0, 3, 5, 4, 3, 2}, wantErr: errShape},
+		{name: "no jump", program: []uint64{0, 3, 5, 4}, wantErr: errShape},
+		{name: "reserved operand", program: []uint64{0, 7, 5, 4, 3, 0}, wantErr: ErrInvalidOperand},
+	}
+
+	for _, tt := range tests {
+		got, err := analyseShape(tt.program)
+		if !errors.Is(err, tt.wantErr) || (err == nil && got != tt.want) {
+			t.Errorf("%s: analyseShape() = %+v, %v, want %+v, %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestSelfReplicatingSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		program []uint64
+		want    uint64
+		wantErr error
+	}{
+		{name: "example", program: []uint64{0, 3, 5, 4, 3, 0}, want: 117440},
+		{name: "odd output count", program: []uint64{0, 1, 5, 4, 5, 4, 3, 0, 1}, wantErr: ErrNoSolution},
+		{name: "no seed", program: []uint64{0, 1, 5, 4, 3, 0}, wantErr: ErrNoSolution},
+		{name: "never halts", program: []uint64{5, 4, 3, 0}, wantErr: ErrSearchLimit},
+	}
+
+	for _, tt := range tests {
+		got, err := findLowestSelfReplicatingSeed(tt.program, Registers{})
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("%s: findLowestSelfReplicatingSeed() = %d, %v, want %d, %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestSearchSeeds(t *testing.T) {
+	// A single loop program with a seed small enough for the exhaustive pass to reach
+	program := []uint64{0, 2, 2, 4, 5, 5, 3, 0}
+	want, err := findLowestSelfReplicatingSeed(program, Registers{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := searchSeeds(program, Registers{}); err != nil || got != want {
+		t.Errorf("searchSeeds() = %d, %v, want %d", got, err, want)
+	}
+
+	// Seeds far beyond the exhaustive pass are built from the end of the output
+	for _, program := range [][]uint64{
+		{0, 3, 5, 4, 3, 0},
+		{2, 4, 1, 1, 7, 5, 1, 5, 4, 0, 0, 3, 5, 5, 3, 0},
+	} {
+		want, err := findLowestSelfReplicatingSeed(program, Registers{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := searchSeeds(program, Registers{}); err != nil || got != want {
+			t.Errorf("searchSeeds(%v) = %d, %v, want %d", program, got, err, want)
+		}
+	}
+}
+
+func TestBacktrackMatchesBruteForce(t *testing.T) {
+	// Random single loop programs, asked for the output of a random seed, against the
+	// lowest seed found by trying each in turn
+	rng := rand.New(rand.NewPCG(17, 22))
+	for range 200 {
+		program := randomLoop(rng)
+		shape, err := analyseShape(program)
+		if err != nil {
+			t.Fatalf("%v: %v", program, err)
+		}
+		seed := 1 + rng.Uint64N(1<<12)
+		target, err := executeProgram(program, Registers{A: seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := seed
+		for s := uint64(1); s < seed; s++ {
+			if out, err := executeProgram(program, Registers{A: s}); err == nil && slices.Equal(out, target) {
+				want = s
+				break
+			}
+		}
+		if got, err := backtrackSeed(program, target, Registers{}, shape); err != nil || got != want {
+			t.Errorf("%v to output %v: backtrackSeed() = %d, %v, want %d", program, target, got, err, want)
+		}
+	}
+}
+
+// randomLoop returns a random single loop program in the shape of the puzzle inputs,
+// which set B and C from A, mix them, shift A and output
+func randomLoop(rng *rand.Rand) []uint64 {
+	body := [][2]uint64{
+		{uint64(BST), 4},
+		{uint64(BXL), rng.Uint64N(8)},
+		{uint64(CDV), 5},
+		{uint64(BXL), rng.Uint64N(8)},
+		{uint64(BXC), rng.Uint64N(8)},
+	}
+	// Keep the instructions that set B and C first, so the rest may read them
+	rng.Shuffle(len(body)-3, func(i, j int) { body[i+3], body[j+3] = body[j+3], body[i+3] })
+	var program []uint64
+	for _, in := range body {
+		program = append(program, in[0], in[1])
+	}
+	at := rng.IntN(2)
+	shift := []uint64{uint64(ADV), 1 + rng.Uint64N(3)}
+	out := []uint64{uint64(OUT), 4 + rng.Uint64N(3)}
+	if at == 0 {
+		program = append(program, shift[0], shift[1], out[0], out[1])
+	} else {
+		program = append(program, out[0], out[1], shift[0], shift[1])
+	}
+	return append(program, uint64(JNZ), 0)
+}
+
+func TestCompiledMatchesInterpreter(t *testing.T) {
+	rng := rand.New(rand.NewPCG(23, 17))
+	for range 2000 {
+		// Random words make invalid instructions, odd jumps and endless loops likely
+		program := make([]uint64, 2+rng.IntN(15))
+		for i := range program {
+			program[i] = rng.Uint64N(9)
+		}
+		regs := Registers{A: rng.Uint64(), B: rng.Uint64N(64), C: rng.Uint64N(64)}
+
+		c := NewComputer(program, regs)
+		c.MaxSteps = 500
+		_, wantErr := c.Run()
+		compiled := Compile(program)
+		compiled.MaxSteps = 500
+		got, err := compiled.Output(regs)
+		if fmt.Sprint(err) != fmt.Sprint(wantErr) || (err == nil && !slices.Equal(got, c.Output)) {
+			t.Fatalf("%v from %v: compiled %v, %v, interpreted %v, %v", program, regs, got, err, c.Output, wantErr)
+		}
+		if wantErr != nil {
+			continue
+		}
+
+		if ok, err := compiled.Outputs(regs, c.Output); !ok || err != nil {
+			t.Errorf("%v from %v: Outputs(%v) = %v, %v, want true", program, regs, c.Output, ok, err)
+		}
+		if ok, _ := compiled.Outputs(regs, append(slices.Clone(c.Output), 0)); ok {
+			t.Errorf("%v from %v: Outputs() matched a longer output", program, regs)
+		}
+	}
+}
+
+func TestOutputsDoesNotAllocate(t *testing.T) {
+	compiled := Compile(benchmarkProgram)
+	regs := Registers{A: benchmarkSeed}
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := compiled.Outputs(regs, benchmarkProgram); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Outputs() made %v allocations, want 0", allocs)
+	}
+}
+
+// benchmarkProgram is in the shape of the puzzle inputs, run from a seed that makes it
+// output 16 values
+var benchmarkProgram = []uint64{2, 4, 1, 1, 7, 5, 1, 5, 4, 0, 0, 3, 5, 5, 3, 0}
+
+const benchmarkSeed = 1<<45 + 12345
+
+func BenchmarkInterpreter(b *testing.B) {
+	b.ReportAllocs()
+	for i := range b.N {
+		if _, err := executeProgram(benchmarkProgram, Registers{A: benchmarkSeed + uint64(i)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCompiled(b *testing.B) {
+	compiled := Compile(benchmarkProgram)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		if _, err := compiled.Output(Registers{A: benchmarkSeed + uint64(i)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCompiledOutputs(b *testing.B) {
+	compiled := Compile(benchmarkProgram)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		if _, err := compiled.Outputs(Registers{A: benchmarkSeed + uint64(i)}, benchmarkProgram); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package day17
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrNoSolution  = errors.New("no initial value of register A makes the program output itself")
+	ErrSearchLimit = errors.New("search limit reached without finding an initial value of register A")
+)
+
+// errShape is returned by analyseShape for programs the backtracking search cannot handle
+var errShape = errors.New("program is not a single loop over register A")
+
+const (
+	// searchLimit bounds the small seeds tried one by one for programs that are not a
+	// single loop over A
+	searchLimit = 1 << 16
+	// searchBudget bounds the seeds run while building them three bits at a time
+	searchBudget = 1 << 20
+	// searchMaxSteps is how many instructions a seed may run for during either search
+	searchMaxSteps = 1 << 12
+)
+
+// loopShape describes a program that is one loop over register A
+type loopShape struct {
+	shift   int // bits A is shifted right by each iteration
+	outputs int // values output each iteration
+}
+
+// analyseShape checks that the program is a single loop ending in "jnz 0" that shifts A
+// right by a constant once per iteration, outputs a fixed number of values, and sets B
+// and C before reading them. Each iteration's output then depends only on the value of
+// A at its start. Programs of any other shape fail with errShape and the reason.
+func analyseShape(program []uint64) (loopShape, error) {
+	instructions := Decode(program)
+	var shape loopShape
+	var setB, setC bool
+	for i, in := range instructions {
+		if in.Op > CDV {
+			return loopShape{}, fmt.Errorf("instruction at %d: %w: %d", in.Addr, ErrInvalidOpcode, uint64(in.Op))
+		}
+		if in.usesCombo() && in.Operand > 6 {
+			return loopShape{}, fmt.Errorf("instruction at %d: %w: %d", in.Addr, ErrInvalidOperand, in.Operand)
+		}
+
+		readsB := (in.usesCombo() && in.Operand == 5) || in.Op == BXL || in.Op == BXC
+		readsC := (in.usesCombo() && in.Operand == 6) || in.Op == BXC
+		if (readsB && !setB) || (readsC && !setC) {
+			return loopShape{}, fmt.Errorf("%w: %s at %d reads a register set by the previous iteration",
+				errShape, in, in.Addr)
+		}
+
+		switch in.Op {
+		case ADV:
+			if shape.shift != 0 {
+				return loopShape{}, fmt.Errorf("%w: A is shifted more than once", errShape)
+			}
+			if in.Operand == 0 || in.Operand > 3 {
+				return loopShape{}, fmt.Errorf("%w: %s at %d does not shift A by a constant", errShape, in, in.Addr)
+			}
+			shape.shift = int(in.Operand)
+		case JNZ:
+			if i != len(instructions)-1 || in.Operand != 0 {
+				return loopShape{}, fmt.Errorf("%w: %s at %d is not a jump back to the start at the end",
+					errShape, in, in.Addr)
+			}
+		case OUT:
+			shape.outputs++
+		case BST, BXL, BXC, BDV:
+			setB = true
+		case CDV:
+			setC = true
+		}
+	}
+
+	switch {
+	case len(instructions) == 0 || instructions[len(instructions)-1].Op != JNZ:
+		return loopShape{}, fmt.Errorf("%w: the program does not end with a jump", errShape)
+	case shape.shift == 0:
+		return loopShape{}, fmt.Errorf("%w: A is never shifted", errShape)
+	case shape.outputs == 0:
+		return loopShape{}, fmt.Errorf("%w: the loop has no output", errShape)
+	}
+	return shape, nil
+}
+
+// findLowestSelfReplicatingSeed finds the lowest positive value of register A that makes
+// the program output a copy of itself. Programs that are a single loop over A are solved
+// by building A from its most significant bits down, one iteration at a time; any others
+// are handed to searchSeeds, which may give up with ErrSearchLimit.
+func findLowestSelfReplicatingSeed(program []uint64, regs Registers) (uint64, error) {
+	shape, err := analyseShape(program)
+	switch {
+	case errors.Is(err, errShape):
+		return searchSeeds(program, regs)
+	case err != nil:
+		return 0, err
+	}
+	return backtrackSeed(program, program, regs, shape)
+}
+
+// backtrackSeed finds the lowest positive seed that makes a single loop program output
+// target, building it from the last iteration back to the first. Iteration i starts with
+// A holding the seed shifted right by i*shift bits, so each earlier iteration adds shift
+// low bits to the value found for the one after it. Trying those bits in ascending order
+// finds the lowest seed first.
+func backtrackSeed(program, target []uint64, regs Registers, shape loopShape) (uint64, error) {
+	if len(target)%shape.outputs != 0 {
+		return 0, fmt.Errorf("%w: each iteration outputs %d values but %d are wanted",
+			ErrNoSolution, shape.outputs, len(target))
+	}
+	iterations := len(target) / shape.outputs
+	compiled := Compile(program)
+
+	var search func(done int, a uint64) (uint64, bool, error)
+	search = func(done int, a uint64) (uint64, bool, error) {
+		if done == iterations {
+			return a, true, nil
+		}
+		if a>>(64-shape.shift) != 0 {
+			return 0, false, nil // shifting in another group would overflow
+		}
+		want := target[len(target)-(done+1)*shape.outputs:]
+		for group := range uint64(1) << shape.shift {
+			next := a<<shape.shift | group
+			if next == 0 {
+				continue // the loop stops once A is zero, and the seed must be positive
+			}
+			regs.A = next
+			ok, err := compiled.Outputs(regs, want)
+			if err != nil {
+				return 0, false, err
+			}
+			if !ok {
+				continue
+			}
+			if seed, ok, err := search(done+1, next); ok || err != nil {
+				return seed, ok, err
+			}
+		}
+		return 0, false, nil
+	}
+
+	seed, ok, err := search(0, 0)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrNoSolution
+	}
+	return seed, nil
+}
+
+// searchSeeds looks for a seed for programs that are not a single loop over A, in two
+// bounded passes. It first tries every seed below searchLimit, so a small seed is found
+// and known to be the lowest. It then builds seeds three bits at a time from the end of
+// the output back, like backtrackSeed, but keeps every seed whose output is any suffix of
+// the program rather than assuming how the loop works. Seeds of each length are tried in
+// ascending order before longer ones, so the first match is the lowest that pass reaches.
+// Seeds running past searchMaxSteps are abandoned, so programs that never halt cannot
+// stall the search. Neither pass covers every seed, so failing is reported with
+// ErrSearchLimit rather than ErrNoSolution.
+func searchSeeds(program []uint64, regs Registers) (uint64, error) {
+	compiled := Compile(program)
+	compiled.MaxSteps = searchMaxSteps
+	for seed := uint64(1); seed < searchLimit; seed++ {
+		regs.A = seed
+		ok, err := compiled.Outputs(regs, program)
+		if err != nil && !errors.Is(err, ErrStepLimit) {
+			return 0, err
+		}
+		if ok {
+			return seed, nil
+		}
+	}
+
+	budget := searchBudget
+	frontier := []uint64{0}
+	for len(frontier) > 0 {
+		var next []uint64
+		for _, a := range frontier {
+			if a>>61 != 0 {
+				continue // shifting in another group would overflow
+			}
+			for group := range uint64(8) {
+				seed := a<<3 | group
+				if seed == 0 {
+					continue
+				}
+				if budget == 0 {
+					return 0, fmt.Errorf("%w: %d seeds run", ErrSearchLimit, searchBudget)
+				}
+				budget--
+
+				regs.A = seed
+				out, err := compiled.Output(regs)
+				switch {
+				case errors.Is(err, ErrStepLimit):
+					continue
+				case err != nil:
+					return 0, err
+				case len(out) > len(program) || !slices.Equal(out, program[len(program)-len(out):]):
+					continue
+				case len(out) == len(program):
+					return seed, nil
+				}
+				next = append(next, seed)
+			}
+		}
+		frontier = next
+	}
+	return 0, fmt.Errorf("%w: no seed below %d, and none built from the end of the output", ErrSearchLimit, searchLimit)
+}
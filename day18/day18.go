@@ -0,0 +1,177 @@
+package day18
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/dsu"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"aoc2024/utility/search"
+	"errors"
+	"iter"
+	"strings"
+)
+
+// Dimensions of the real memory space and how many bytes have fallen in part 1
+const (
+	memorySize = 71
+	kilobyte   = 1024
+)
+
+var (
+	ErrNoPath         = errors.New("no path to the exit")
+	ErrNoBlockingByte = errors.New("no byte blocks the path to the exit")
+)
+
+// parseInput reads coordinates from string slice and returns a slice of Points
+func parseInput(input []string) ([]geom.Point, error) {
+	points := make([]geom.Point, 0, len(input))
+	for _, line := range input {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		p, err := geom.ParsePoint(line)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// createGrid creates a grid representation with the first n corrupted points.
+// Points outside the grid are ignored.
+func createGrid(points []geom.Point, gridSize, n int) *grid.Grid[bool] {
+	corrupted := grid.New[bool](gridSize, gridSize)
+	for _, p := range points[:min(n, len(points))] {
+		corrupted.Set(p, true)
+	}
+	return corrupted
+}
+
+// openNeighbors returns the neighbour function for the uncorrupted cells of the grid
+func openNeighbors(corrupted *grid.Grid[bool]) func(geom.Point) iter.Seq[geom.Point] {
+	return func(p geom.Point) iter.Seq[geom.Point] {
+		return func(yield func(geom.Point) bool) {
+			for next, isCorrupted := range corrupted.Neighbors4(p) {
+				if !isCorrupted && !yield(next) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// findShortestPath returns the number of steps in the shortest path from start to end
+// avoiding corrupted memory, or -1 if there is none
+func findShortestPath(corrupted *grid.Grid[bool], start, end geom.Point) int {
+	if corrupted.At(start) {
+		return -1
+	}
+	isEnd := func(p geom.Point) bool { return p == end }
+	return search.BFS(start, openNeighbors(corrupted), isEnd).Cost()
+}
+
+// findBlockingByte finds the first byte that blocks all paths to the exit. It works
+// backwards from the memory space with every byte fallen, clearing the bytes in reverse
+// order and joining each cleared cell to its free neighbours; the first byte whose
+// removal connects the corners is the one that cut them off. It returns false if the
+// exit is still reachable once every byte has fallen.
+func findBlockingByte(points []geom.Point, gridSize int) (geom.Point, bool) {
+	start := geom.Point{X: 0, Y: 0}
+	end := geom.Point{X: gridSize - 1, Y: gridSize - 1}
+
+	// fallsAt holds the index of the first byte to land on each cell, or len(points)
+	// for cells no byte reaches
+	fallsAt := grid.New[int](gridSize, gridSize)
+	for p := range fallsAt.All() {
+		fallsAt.Set(p, len(points))
+	}
+	for i, p := range points {
+		if t, ok := fallsAt.Get(p); ok && i < t {
+			fallsAt.Set(p, i)
+		}
+	}
+
+	// open joins p to the neighbours that are still free before byte i falls
+	free := dsu.New[geom.Point]()
+	open := func(p geom.Point, i int) {
+		free.Add(p)
+		for next, t := range fallsAt.Neighbors4(p) {
+			if t >= i {
+				free.Union(p, next)
+			}
+		}
+	}
+
+	for p, t := range fallsAt.All() {
+		if t == len(points) {
+			open(p, t)
+		}
+	}
+	if free.Connected(start, end) {
+		return geom.Point{}, false
+	}
+	for i := len(points) - 1; i >= 0; i-- {
+		if t, ok := fallsAt.Get(points[i]); !ok || t != i {
+			continue // outside the memory space or already fallen
+		}
+		open(points[i], i)
+		if free.Connected(start, end) {
+			return points[i], true
+		}
+	}
+	return geom.Point{}, false
+}
+
+// part1 returns the fewest steps from the top left to the bottom right corner of a
+// size x size memory space after the first n bytes have fallen
+func part1(input []string, size, n int) (int, error) {
+	points, err := parseInput(input)
+	if err != nil {
+		return 0, err
+	}
+
+	corrupted := createGrid(points, size, n)
+	start := geom.Point{X: 0, Y: 0}
+	end := geom.Point{X: size - 1, Y: size - 1}
+
+	steps := findShortestPath(corrupted, start, end)
+	if steps == -1 {
+		return 0, ErrNoPath
+	}
+	return steps, nil
+}
+
+// part2 returns the coordinates of the first byte that cuts the exit off in a
+// size x size memory space
+func part2(input []string, size int) (string, error) {
+	points, err := parseInput(input)
+	if err != nil {
+		return "", err
+	}
+
+	blockingByte, ok := findBlockingByte(points, size)
+	if !ok {
+		return "", ErrNoBlockingByte
+	}
+	return blockingByte.String(), nil
+}
+
+// Solver solves day 18
+type Solver struct{}
+
+func init() {
+	registry.Register(18, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input, memorySize, kilobyte)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input, memorySize)
+}
@@ -0,0 +1,95 @@
+package day18
+
+import (
+	"aoc2024/utility"
+	"aoc2024/utility/geom"
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		size, bytes int
+		want        int
+		wantErr     error
+	}{
+		{size: 7, bytes: 12, want: 22},
+		{size: 7, bytes: 0, want: 12},
+		{size: 7, bytes: 25, wantErr: ErrNoPath},
+	}
+
+	for _, tt := range tests {
+		got, err := part1(input, tt.size, tt.bytes)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("part1(%d, %d) error = %v, want %v", tt.size, tt.bytes, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("part1(%d, %d) = %v, want %v", tt.size, tt.bytes, got, tt.want)
+		}
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		input   []string
+		want    string
+		wantErr error
+	}{
+		{want: "6,1"},
+		{input: []string{"0,1", "1,1"}, wantErr: ErrNoBlockingByte},
+	}
+
+	for _, tt := range tests {
+		input := tt.input
+		if input == nil {
+			var err error
+			if input, err = utility.ParseTextFile("test"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		got, err := part2(input, 7)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("part2() error = %v, want %v", err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("part2() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+// blockingByteBruteForce reruns the search after every byte, for checking findBlockingByte
+func blockingByteBruteForce(points []geom.Point, size int) (geom.Point, bool) {
+	start, end := geom.Point{X: 0, Y: 0}, geom.Point{X: size - 1, Y: size - 1}
+	for i := range points {
+		if findShortestPath(createGrid(points, size, i+1), start, end) == -1 {
+			return points[i], true
+		}
+	}
+	return geom.Point{}, false
+}
+
+func TestFindBlockingByte(t *testing.T) {
+	rng := rand.New(rand.NewPCG(18, 2024))
+	for range 200 {
+		// Some bytes repeat and some fall outside the memory space
+		size := 2 + rng.IntN(8)
+		points := make([]geom.Point, rng.IntN(size*size+5))
+		for i := range points {
+			points[i] = geom.Point{X: rng.IntN(size + 1), Y: rng.IntN(size + 1)}
+		}
+
+		got, gotOK := findBlockingByte(points, size)
+		want, wantOK := blockingByteBruteForce(points, size)
+		if got != want || gotOK != wantOK {
+			t.Fatalf("findBlockingByte(%v, %d) = %v, %v, want %v, %v", points, size, got, gotOK, want, wantOK)
+		}
+	}
+}
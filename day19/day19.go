@@ -0,0 +1,107 @@
+package day19
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/memo"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidFormat is returned when the input is not a line of towel patterns, a blank
+// line and the designs
+var ErrInvalidFormat = errors.New("invalid input format: want patterns, a blank line and designs")
+
+// parseInput reads the comma-separated towel patterns and the designs. An empty pattern
+// could be used any number of times, so it is rejected.
+func parseInput(input []string) (patterns, designs []string, err error) {
+	sections := parse.Sections(input)
+	if len(sections) == 0 || len(sections) > 2 {
+		return nil, nil, fmt.Errorf("%w: found %d sections", ErrInvalidFormat, len(sections))
+	}
+	first := sections[0]
+	if len(first.Lines) != 1 {
+		return nil, nil, &parse.LineError{Line: first.Start + 1, Text: first.Lines[1], Err: ErrInvalidFormat}
+	}
+
+	for _, pattern := range strings.Split(first.Lines[0], ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			err := fmt.Errorf("%w: empty pattern", ErrInvalidFormat)
+			return nil, nil, &parse.LineError{Line: first.Start, Text: first.Lines[0], Err: err}
+		}
+		patterns = append(patterns, pattern)
+	}
+	if len(sections) == 2 {
+		designs, err = parse.Records(sections[1], func(line string) (string, error) {
+			return strings.TrimSpace(line), nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return patterns, designs, nil
+}
+
+func solve(input []string) (int, int, error) {
+	patterns, designs, err := parseInput(input)
+	if err != nil {
+		return 0, 0, err
+	}
+	arrangements := newArrangements(patterns)
+
+	// A design is possible when it has at least one arrangement
+	part1Count := 0
+	part2Sum := 0
+
+	for _, design := range designs {
+		if combinations := arrangements.Call(design); combinations > 0 {
+			part1Count++
+			part2Sum += combinations
+		}
+	}
+
+	return part1Count, part2Sum, nil
+}
+
+// newArrangements returns a memoized count of the ways to build a design from patterns.
+// Designs often end the same way, so the cache is shared between them.
+func newArrangements(patterns []string) *memo.Func[string, int] {
+	return memo.New(0, func(arrangements func(string) int, remaining string) int {
+		if remaining == "" {
+			return 1
+		}
+
+		total := 0
+		for _, pattern := range patterns {
+			if strings.HasPrefix(remaining, pattern) {
+				total += arrangements(remaining[len(pattern):])
+			}
+		}
+		return total
+	})
+}
+
+func countCombinations(design string, patterns []string) int {
+	return newArrangements(patterns).Call(design)
+}
+
+// Solver solves day 19
+type Solver struct{}
+
+func init() {
+	registry.Register(19, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	part1, _, err := solve(input)
+	return part1, err
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	_, part2, err := solve(input)
+	return part2, err
+}
@@ -0,0 +1,82 @@
+package day19
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	part1, part2, err := solve(input)
+	if err != nil || part1 != 6 || part2 != 16 {
+		t.Errorf("solve() = %d, %d, %v, want 6, 16", part1, part2, err)
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+	}{
+		{name: "empty", input: nil},
+		{name: "blank", input: []string{"", ""}},
+		{name: "two pattern lines", input: []string{"r, wr", "b", "", "rwr"}},
+		{name: "empty pattern", input: []string{"r,, b", "", "rb"}},
+		{name: "three sections", input: []string{"r", "", "rr", "", "r"}},
+	}
+
+	for _, tt := range tests {
+		if _, _, err := solve(tt.input); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("%s: solve() error = %v, want %v", tt.name, err, ErrInvalidFormat)
+		}
+	}
+}
+
+func TestCountCombinations(t *testing.T) {
+	patterns := []string{"r", "wr", "b", "g", "bwu", "rb", "gb", "br"}
+	tests := []struct {
+		design string
+		want   int
+	}{
+		{design: "brwrr", want: 2},
+		{design: "bggr", want: 1},
+		{design: "gbbr", want: 4},
+		{design: "rrbgbr", want: 6},
+		{design: "ubwu", want: 0},
+		{design: "bwurrg", want: 1},
+		{design: "brgr", want: 2},
+		{design: "bbrgwb", want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := countCombinations(tt.design, patterns); got != tt.want {
+			t.Errorf("countCombinations(%q) = %d, want %d", tt.design, got, tt.want)
+		}
+	}
+}
+
+func TestArrangementsCache(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patterns, designs, err := parseInput(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	arrangements := newArrangements(patterns)
+	for _, design := range designs {
+		arrangements.Call(design)
+	}
+	stats := arrangements.Stats()
+	if stats.Hits == 0 {
+		t.Errorf("cache was never hit: %v", stats)
+	}
+	t.Logf("cache: %v", stats)
+}
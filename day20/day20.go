@@ -0,0 +1,137 @@
+package day20
+
+import (
+	"aoc2024/registry"
+	. "aoc2024/utility"
+	"aoc2024/utility/container"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"errors"
+)
+
+// ErrNoTrack is returned when the racetrack lacks a start ('S') or end ('E')
+var ErrNoTrack = errors.New("racetrack needs a start and an end")
+
+// Check if char is valid path
+func isValidChar(c byte) bool {
+	return c == 'S' || c == 'E' || c == '.'
+}
+
+// Check if position exists in track map
+func isInTrack(track map[geom.Point]int, pos geom.Point) bool {
+	_, exists := track[pos]
+	return exists
+}
+
+// Find all possible cheat endpoints within maxDist
+func findCheatEndpoints(pos geom.Point, track map[geom.Point]int, maxDist int) container.Set[geom.Point] {
+	endpoints := container.NewSet[geom.Point]()
+	for dy := -maxDist; dy <= maxDist; dy++ {
+		maxX := maxDist - Abs(dy)
+		for dx := -maxX; dx <= maxX; dx++ {
+			newPos := pos.Add(geom.Point{X: dx, Y: dy})
+			if _, exists := track[newPos]; exists {
+				endpoints.Add(newPos)
+			}
+		}
+	}
+	return endpoints
+}
+
+// Calculate if a cheat is valid and saves at least minSaving picoseconds
+func isValidCheat(startPos, endPos geom.Point, track map[geom.Point]int, maxCheatLen, minSaving int) bool {
+	cheatDist := startPos.Manhattan(endPos)
+	if cheatDist > maxCheatLen {
+		return false
+	}
+
+	timeSaved := track[endPos] - track[startPos] - cheatDist
+	return timeSaved >= minSaving
+}
+
+// Find next valid move in BFS
+func findNextMove(cur geom.Point, racetrack *grid.Grid[byte], track map[geom.Point]int) (geom.Point, bool) {
+	for newPos, c := range racetrack.Neighbors4(cur) {
+		if !isInTrack(track, newPos) && isValidChar(c) {
+			return newPos, true
+		}
+	}
+	return geom.Point{}, false
+}
+
+// Perform BFS to build track map
+func buildTrackMap(racetrack *grid.Grid[byte], start, end geom.Point) map[geom.Point]int {
+	track := make(map[geom.Point]int)
+	track[start] = 0
+	cur := start
+	curStep := 0
+
+	for cur != end {
+		curStep++
+		nextPos, found := findNextMove(cur, racetrack, track)
+		if !found {
+			break
+		}
+		track[nextPos] = curStep
+		cur = nextPos
+	}
+
+	return track
+}
+
+// Count valid cheats from track map
+func countValidCheats(track map[geom.Point]int, maxCheatLen, minSaving int) int {
+	count := 0
+	for startPos := range track {
+		endpoints := findCheatEndpoints(startPos, track, maxCheatLen)
+		for endPos := range endpoints {
+			if isValidCheat(startPos, endPos, track, maxCheatLen, minSaving) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// minimumSaving is how many picoseconds a cheat must save to be counted in the real puzzle
+const minimumSaving = 100
+
+func solve(input []string, maxCheatLen, minSaving int) (int, error) {
+	racetrack, err := grid.Bytes(input)
+	if err != nil {
+		return 0, err
+	}
+	start, foundStart := racetrack.Find(func(c byte) bool { return c == 'S' })
+	end, foundEnd := racetrack.Find(func(c byte) bool { return c == 'E' })
+	if !foundStart || !foundEnd {
+		return 0, ErrNoTrack
+	}
+
+	track := buildTrackMap(racetrack, start, end)
+	return countValidCheats(track, maxCheatLen, minSaving), nil
+}
+
+func part1(input []string, minSaving int) (int, error) {
+	return solve(input, 2, minSaving)
+}
+
+func part2(input []string, minSaving int) (int, error) {
+	return solve(input, 20, minSaving)
+}
+
+// Solver solves day 20
+type Solver struct{}
+
+func init() {
+	registry.Register(20, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input, minimumSaving)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input, minimumSaving)
+}
@@ -0,0 +1,38 @@
+package day20
+
+import (
+	"aoc2024/utility"
+	"testing"
+)
+
+func TestParts(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		solve     func([]string, int) (int, error)
+		minSaving int
+		want      int
+	}{
+		{name: "part1", solve: part1, minSaving: 1, want: 44},
+		{name: "part1", solve: part1, minSaving: 20, want: 5},
+		{name: "part1", solve: part1, minSaving: 64, want: 1},
+		{name: "part1", solve: part1, minSaving: 65, want: 0},
+		{name: "part2", solve: part2, minSaving: 50, want: 285},
+		{name: "part2", solve: part2, minSaving: 74, want: 7},
+		{name: "part2", solve: part2, minSaving: 76, want: 3},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.solve(input, tt.minSaving)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s(%d) = %d, want %d", tt.name, tt.minSaving, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package day21
+
+import (
+	"aoc2024/registry"
+	. "aoc2024/utility"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/memo"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFormat is returned for codes that are not digits followed by A
+var ErrInvalidFormat = errors.New("invalid code: want digits followed by A")
+
+// Key positions on the keypads. Rows are counted upwards from the bottom row, so
+// pressing ^ increases Y, unlike the downward rows of geom.Directions.
+var numericalMap = map[string]geom.Point{
+	"A": {X: 2, Y: 0},
+	"0": {X: 1, Y: 0},
+	"1": {X: 0, Y: 1},
+	"2": {X: 1, Y: 1},
+	"3": {X: 2, Y: 1},
+	"4": {X: 0, Y: 2},
+	"5": {X: 1, Y: 2},
+	"6": {X: 2, Y: 2},
+	"7": {X: 0, Y: 3},
+	"8": {X: 1, Y: 3},
+	"9": {X: 2, Y: 3},
+}
+
+var directionalMap = map[string]geom.Point{
+	"A": {X: 2, Y: 1},
+	"^": {X: 1, Y: 1},
+	"<": {X: 0, Y: 0},
+	"v": {X: 1, Y: 0},
+	">": {X: 2, Y: 0},
+}
+
+// parseCode checks that a line is a door code, such as 029A
+func parseCode(line string) (string, error) {
+	code := strings.TrimSpace(line)
+	digits, found := strings.CutSuffix(code, "A")
+	if !found || digits == "" {
+		return "", ErrInvalidFormat
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return "", fmt.Errorf("%w: %q is not a digit", ErrInvalidFormat, c)
+		}
+	}
+	return code, nil
+}
+
+func getNumericValue(code string) int {
+	numStr := strings.TrimLeft(code[:len(code)-1], "0")
+	if numStr == "" {
+		return 0
+	}
+	num, _ := strconv.Atoi(numStr)
+	return num
+}
+
+func getPresses(input []string, start string, coordMap map[string]geom.Point, prioritizeMovement func(geom.Point, geom.Point) bool) []string { //nolint:lll
+	current := coordMap[start]
+	output := make([]string, 0)
+
+	for _, char := range input {
+		dest := coordMap[char]
+		diff := dest.Sub(current)
+		diffX, diffY := diff.X, diff.Y
+
+		horizontal := make([]string, 0)
+		vertical := make([]string, 0)
+
+		for i := 0; i < Abs(diffX); i++ {
+			if diffX >= 0 {
+				horizontal = append(horizontal, ">")
+			} else {
+				horizontal = append(horizontal, "<")
+			}
+		}
+
+		for i := 0; i < Abs(diffY); i++ {
+			if diffY >= 0 {
+				vertical = append(vertical, "^")
+			} else {
+				vertical = append(vertical, "v")
+			}
+		}
+
+		if prioritizeMovement(current, dest) {
+			output = append(output, vertical...)
+			output = append(output, horizontal...)
+		} else {
+			output = append(output, horizontal...)
+			output = append(output, vertical...)
+		}
+
+		current = dest
+		output = append(output, "A")
+	}
+	return output
+}
+
+func prioritizeNumeric(current, dest geom.Point) bool {
+	diffX := dest.X - current.X
+	switch {
+	case current.Y == 0 && dest.X == 0:
+		return true
+	case current.X == 0 && dest.Y == 0:
+		return false
+	default:
+		return diffX >= 0
+	}
+}
+
+func prioritizeDirectional(current, dest geom.Point) bool {
+	diffX := dest.X - current.X
+	switch {
+	case current.X == 0 && dest.Y == 1:
+		return false
+	case current.Y == 1 && dest.X == 0:
+		return true
+	default:
+		return diffX >= 0
+	}
+}
+
+func getIndividualSteps(input []string) [][]string {
+	output := make([][]string, 0)
+	current := make([]string, 0)
+	for _, char := range input {
+		current = append(current, char)
+		if char == "A" {
+			output = append(output, current)
+			current = []string{}
+		}
+	}
+	return output
+}
+
+// pressKey is a sequence of directional presses to be typed by a chain of robots
+type pressKey struct {
+	presses string
+	robots  int
+}
+
+// newPressCounter returns a memoized count of the presses the human makes for a chain of
+// robots to type a sequence, the last robot in the chain typing it on a directional
+// keypad. Every sequence ends in A, which brings all the robots back to A, so a sequence
+// splits into steps that can be counted independently.
+func newPressCounter(directionalMap map[string]geom.Point) *memo.Func[pressKey, int] {
+	return memo.New(0, func(count func(pressKey) int, key pressKey) int {
+		seq := getPresses(strings.Split(key.presses, ""), "A", directionalMap, prioritizeDirectional)
+		if key.robots == 1 {
+			return len(seq)
+		}
+
+		total := 0
+		for _, step := range getIndividualSteps(seq) {
+			total += count(pressKey{strings.Join(step, ""), key.robots - 1})
+		}
+		return total
+	})
+}
+
+func getSequence(input []string, numericalMap, directionalMap map[string]geom.Point, robots int) (int, error) {
+	codes, err := parse.Records(parse.Input(input), parseCode)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	presses := newPressCounter(directionalMap)
+	for _, line := range codes {
+		row := strings.Split(line, "")
+		seq1 := getPresses(row, "A", numericalMap, prioritizeNumeric)
+		num := presses.Call(pressKey{strings.Join(seq1, ""), robots})
+		count += getNumericValue(line) * num
+	}
+	return count, nil
+}
+
+// Solver solves day 21
+type Solver struct{}
+
+func init() {
+	registry.Register(21, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return getSequence(input, numericalMap, directionalMap, 2)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return getSequence(input, numericalMap, directionalMap, 25)
+}
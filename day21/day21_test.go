@@ -0,0 +1,64 @@
+package day21
+
+import (
+	"aoc2024/utility"
+	"aoc2024/utility/parse"
+	"errors"
+	"testing"
+)
+
+func TestGetSequence(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		robots int
+		want   int
+	}{
+		{name: "part1", robots: 2, want: 126384},
+		{name: "part2", robots: 25, want: 154115708116294},
+	}
+
+	for _, tt := range tests {
+		if got, err := getSequence(input, numericalMap, directionalMap, tt.robots); err != nil || got != tt.want {
+			t.Errorf("%s: getSequence() = %d, %v, want %d", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestComplexityPerCode(t *testing.T) {
+	tests := []struct {
+		code string
+		want int
+	}{
+		{code: "029A", want: 68 * 29},
+		{code: "980A", want: 60 * 980},
+		{code: "179A", want: 68 * 179},
+		{code: "456A", want: 64 * 456},
+		{code: "379A", want: 64 * 379},
+	}
+
+	for _, tt := range tests {
+		if got, err := getSequence([]string{tt.code}, numericalMap, directionalMap, 2); err != nil || got != tt.want {
+			t.Errorf("getSequence(%s) = %d, %v, want %d", tt.code, got, err, tt.want)
+		}
+	}
+}
+
+func TestInvalidCodes(t *testing.T) {
+	for _, code := range []string{"A", "029", "02xA", "029B"} {
+		_, err := getSequence([]string{"029A", code}, numericalMap, directionalMap, 2)
+		var lineErr *parse.LineError
+		if !errors.Is(err, ErrInvalidFormat) || !errors.As(err, &lineErr) || lineErr.Line != 2 {
+			t.Errorf("getSequence(%q) error = %v, want %v on line 2", code, err, ErrInvalidFormat)
+		}
+	}
+
+	// Blank lines are skipped rather than read as codes
+	if got, err := getSequence([]string{"", "029A", ""}, numericalMap, directionalMap, 2); err != nil || got != 68*29 {
+		t.Errorf("getSequence() with blank lines = %d, %v, want %d", got, err, 68*29)
+	}
+}
@@ -1,8 +1,10 @@
-package main
+package day22
 
 import (
+	"aoc2024/registry"
 	"aoc2024/utility"
-	"log"
+	"aoc2024/utility/parse"
+	"fmt"
 	"strconv"
 )
 
@@ -32,11 +34,19 @@ func prune(value int) int {
 	return value % 16777216
 }
 
-func findSecretNumber(input int) int {
-	input = prune(mix(64*input, input))
+// findSecretNumber returns the secret number that follows input. Pruning keeps every
+// later number below 2^24, so only a huge starting number can overflow.
+func findSecretNumber(input int) (int, error) {
+	v, err := utility.MulChecked(input, 64)
+	if err != nil {
+		return 0, err
+	}
+	input = prune(mix(v, input))
 	input = prune(mix(input/32, input))
-	input = prune(mix(input*2048, input))
-	return input
+	if v, err = utility.MulChecked(input, 2048); err != nil {
+		return 0, err
+	}
+	return prune(mix(v, input)), nil
 }
 
 func findMaxNumberOfBananas(b [][]bananaPrice) int {
@@ -82,37 +92,54 @@ func findMaxNumberOfBananas(b [][]bananaPrice) int {
 	return maxBananas
 }
 
-func solve(input []string) (int, int) {
+func solve(input []string) (int, int, error) {
+	secrets, err := parse.Records(parse.Input(input), strconv.Atoi)
+	if err != nil {
+		return 0, 0, err
+	}
+
 	var sum int
-	priceData := make([][]bananaPrice, len(input))
+	priceData := make([][]bananaPrice, len(secrets))
 
 	// Process each buyer
-	for i, line := range input {
-		num, _ := strconv.Atoi(line)
+	for i, num := range secrets {
 		prev := num % 10 // Initial price
 
 		// Generate 2000 numbers and their changes
 		priceData[i] = make([]bananaPrice, 2000)
 		for j := 0; j < 2000; j++ {
-			num = findSecretNumber(num)
+			if num, err = findSecretNumber(num); err != nil {
+				return 0, 0, fmt.Errorf("buyer %d: %w", i+1, err)
+			}
 			priceData[i][j] = getNumAndChange(num, prev)
 			prev = num % 10
 
 			if j == 1999 { // Last number for part 1
-				sum += num
+				if sum, err = utility.AddChecked(sum, num); err != nil {
+					return 0, 0, err
+				}
 			}
 		}
 	}
 
-	return sum, findMaxNumberOfBananas(priceData)
+	return sum, findMaxNumberOfBananas(priceData), nil
 }
 
-func main() {
-	input, err := utility.ParseTextFile("input")
-	if err != nil {
-		log.Fatal(err)
-	}
-	part1, part2 := solve(input)
-	log.Printf("Part 1: %d\n", part1)
-	log.Printf("Part 2: %d\n", part2)
+// Solver solves day 22
+type Solver struct{}
+
+func init() {
+	registry.Register(22, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	part1, _, err := solve(input)
+	return part1, err
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	_, part2, err := solve(input)
+	return part2, err
 }
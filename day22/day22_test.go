@@ -0,0 +1,68 @@
+package day22
+
+import (
+	"aoc2024/utility"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestFindSecretNumber(t *testing.T) {
+	want := []int{
+		15887950, 16495136, 527345, 704524, 1553684,
+		12683156, 11100544, 12249484, 7753432, 5908254,
+	}
+
+	secret := 123
+	for i, w := range want {
+		var err error
+		if secret, err = findSecretNumber(secret); err != nil {
+			t.Fatal(err)
+		}
+		if secret != w {
+			t.Fatalf("secret %d = %d, want %d", i+1, secret, w)
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		file      string
+		wantPart1 int
+		wantPart2 int
+	}{
+		{file: "test", wantPart1: 37327623, wantPart2: 24},
+		{file: "test2", wantPart1: 37990510, wantPart2: 23},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part1, part2, err := solve(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if part1 != tt.wantPart1 || part2 != tt.wantPart2 {
+			t.Errorf("%s: solve() = %d, %d, want %d, %d", tt.file, part1, part2, tt.wantPart1, tt.wantPart2)
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  error
+	}{
+		{name: "not a number", input: []string{"1", "x"}, want: strconv.ErrSyntax},
+		{name: "huge secret", input: []string{"1", "1000000000000000000"}, want: utility.ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		if _, _, err := solve(tt.input); !errors.Is(err, tt.want) {
+			t.Errorf("%s: solve() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -1,8 +1,7 @@
-package main
+package day23
 
 import (
-	"aoc2024/utility"
-	"log"
+	"aoc2024/registry"
 	"sort"
 	"strings"
 )
@@ -251,11 +250,19 @@ func part2(input []string) string {
 	return strings.Join(maxClique, ",")
 }
 
-func main() {
-	input, err := utility.ParseTextFile("input")
-	if err != nil {
-		log.Fatal(err)
-	}
-	log.Println(part1(input))
-	log.Println(part2(input))
+// Solver solves day 23
+type Solver struct{}
+
+func init() {
+	registry.Register(23, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input), nil
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	return part2(input), nil
 }
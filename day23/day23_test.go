@@ -0,0 +1,43 @@
+package day23
+
+import (
+	"aoc2024/utility"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 7
+	got := part1(input)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("part1() = %v, want %v", got, want)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  string
+	}{
+		{name: "example", want: "co,de,ka,ta"},
+		{name: "triangle", input: []string{"a-b", "b-c", "c-a", "c-d"}, want: "a,b,c"},
+	}
+
+	for _, tt := range tests {
+		input := tt.input
+		if input == nil {
+			var err error
+			if input, err = utility.ParseTextFile("test"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := part2(input); got != tt.want {
+			t.Errorf("%s: part2() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,428 @@
+package day24
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNotAdder = errors.New("circuit is not an adder")
+	ErrNoRepair = errors.New("no swaps repair the adder")
+)
+
+const (
+	// maxSwaps is how many pairs of gate outputs the puzzle swapped
+	maxSwaps = 4
+	// maxWidth keeps the sum of two inputs, one bit wider, in a uint64
+	maxWidth = 63
+	// confirmTrials is how many random additions a repaired adder must get right
+	confirmTrials = 100
+)
+
+// Fault is a gate that breaks the ripple-carry structure at one bit
+type Fault struct {
+	Bit    int
+	Gate   string // output wire of the gate at fault
+	Reason string
+}
+
+func (f Fault) String() string {
+	return fmt.Sprintf("bit %d: %s: %s", f.Bit, f.Gate, f.Reason)
+}
+
+// Diagnose checks the circuit in the puzzle input against a ripple-carry adder bit by bit
+// and returns every fault found, in bit order. A correct adder has none.
+func Diagnose(input []string) ([]Fault, error) {
+	_, dependencies, err := parseInput(input)
+	if err != nil {
+		return nil, err
+	}
+	a, err := newAdder(dependencies)
+	if err != nil {
+		return nil, err
+	}
+	faults, _ := a.verify()
+	slices.SortStableFunc(faults, func(f, g Fault) int { return f.Bit - g.Bit })
+	return faults, nil
+}
+
+// Repair finds the pairs of gate outputs whose swapping makes the circuit in the puzzle
+// input a ripple-carry adder
+func Repair(input []string) ([][2]string, error) {
+	_, dependencies, err := parseInput(input)
+	if err != nil {
+		return nil, err
+	}
+	return repairAdder(dependencies)
+}
+
+// repairAdder finds the swaps repairing a circuit, using a fixed seed for the random
+// additions confirming them so that results are repeatable
+func repairAdder(dependencies map[string]dependency) ([][2]string, error) {
+	a, err := newAdder(dependencies)
+	if err != nil {
+		return nil, err
+	}
+	return a.repair(rand.New(rand.NewPCG(24, 2024)))
+}
+
+// gateKey identifies a gate by its operation and its inputs in sorted order
+type gateKey struct {
+	w1, w2 string
+	op     string
+}
+
+func keyOf(w1, op, w2 string) gateKey {
+	if w2 < w1 {
+		w1, w2 = w2, w1
+	}
+	return gateKey{w1, w2, op}
+}
+
+// adder is a circuit expected to add the numbers on its x and y wires into its z wires
+// as a ripple-carry adder. Bit 0 is a half adder, z00 = x00 XOR y00 with carry
+// x00 AND y00, and every other bit i is a full adder:
+//
+//	p = xi XOR yi, g = xi AND yi
+//	zi = p XOR carry, t = p AND carry, carry out = g OR t
+//
+// The last carry out is the top z wire.
+type adder struct {
+	width  int
+	gates  map[string]dependency // gate driving each wire
+	byKey  map[gateKey]string    // output of each gate
+	inputs map[string][]string   // outputs of the gates reading each wire
+}
+
+func wireName(prefix byte, bit int) string {
+	return fmt.Sprintf("%c%02d", prefix, bit)
+}
+
+// newAdder works out the width of the adder from its x, y and z wires and indexes its gates
+func newAdder(dependencies map[string]dependency) (*adder, error) {
+	bits := map[byte]map[int]bool{'x': {}, 'y': {}, 'z': {}}
+	note := func(w string) {
+		if set, ok := bits[w[0]]; ok {
+			if n, err := strconv.Atoi(w[1:]); err == nil && n >= 0 {
+				set[n] = true
+			}
+		}
+	}
+	for out, d := range dependencies {
+		note(out)
+		note(d.w1)
+		note(d.w2)
+	}
+
+	width := len(bits['x'])
+	switch {
+	case width == 0:
+		return nil, fmt.Errorf("%w: no x wires", ErrNotAdder)
+	case width > maxWidth:
+		return nil, fmt.Errorf("%w: %d bits is wider than %d", ErrNotAdder, width, maxWidth)
+	}
+	for _, prefix := range []byte{'x', 'y', 'z'} {
+		want := width
+		if prefix == 'z' {
+			want++
+		}
+		for i := range want {
+			if !bits[prefix][i] {
+				return nil, fmt.Errorf("%w: %d bits wide but %s is missing", ErrNotAdder, width, wireName(prefix, i))
+			}
+		}
+		if len(bits[prefix]) != want {
+			return nil, fmt.Errorf("%w: %d %c wires for %d bits", ErrNotAdder, len(bits[prefix]), prefix, width)
+		}
+	}
+
+	a := &adder{width: width, gates: make(map[string]dependency, len(dependencies))}
+	for out, d := range dependencies {
+		a.gates[out] = d
+	}
+	a.index()
+	return a, nil
+}
+
+// index rebuilds the lookups after the gates change
+func (a *adder) index() {
+	a.byKey = make(map[gateKey]string, len(a.gates))
+	a.inputs = make(map[string][]string)
+	for out, d := range a.gates {
+		a.byKey[keyOf(d.w1, d.op, d.w2)] = out
+		a.inputs[d.w1] = append(a.inputs[d.w1], out)
+		a.inputs[d.w2] = append(a.inputs[d.w2], out)
+	}
+}
+
+// find returns the output of the gate applying op to w1 and w2
+func (a *adder) find(w1, op, w2 string) (string, bool) {
+	out, ok := a.byKey[keyOf(w1, op, w2)]
+	return out, ok
+}
+
+// swap exchanges the outputs of the gates driving w1 and w2
+func (a *adder) swap(w1, w2 string) {
+	d1, d2 := a.gates[w1], a.gates[w2]
+	a.gates[w1], a.gates[w2] = d2, d1
+	a.byKey[keyOf(d1.w1, d1.op, d1.w2)] = w2
+	a.byKey[keyOf(d2.w1, d2.op, d2.w2)] = w1
+
+	seen := make(map[string]bool, 4)
+	for _, in := range []string{d1.w1, d1.w2, d2.w1, d2.w2} {
+		if seen[in] {
+			continue
+		}
+		seen[in] = true
+		for j, out := range a.inputs[in] {
+			switch out {
+			case w1:
+				a.inputs[in][j] = w2
+			case w2:
+				a.inputs[in][j] = w1
+			}
+		}
+	}
+}
+
+// readBy returns the output of a gate applying op to w and some other wire, and that wire
+func (a *adder) readBy(w, op string) (out, other string, ok bool) {
+	for _, out := range a.inputs[w] {
+		if d := a.gates[out]; d.op == op {
+			if d.w1 == w {
+				return out, d.w2, true
+			}
+			return out, d.w1, true
+		}
+	}
+	return "", "", false
+}
+
+// verify checks the adder bit by bit against the ripple-carry structure. It returns
+// every fault found and, for each bit, the wire it takes as its carry in, which is
+// empty where no carry could be found.
+func (a *adder) verify() ([]Fault, []string) {
+	var faults []Fault
+	report := func(bit int, wire, format string, args ...any) {
+		faults = append(faults, Fault{Bit: bit, Gate: wire, Reason: fmt.Sprintf(format, args...)})
+	}
+	carries := make([]string, a.width+1)
+
+	x, y, z := wireName('x', 0), wireName('y', 0), wireName('z', 0)
+	if sum, _ := a.find(x, xorGate, y); sum != z {
+		report(0, sum, "%s XOR %s drives %s, want %s", x, y, sum, z)
+	}
+	carries[1], _ = a.find(x, andGate, y)
+	for i := 1; i < a.width; i++ {
+		carries[i+1] = a.verifyBit(i, carries[i], report)
+	}
+
+	if top := wireName('z', a.width); carries[a.width] != top {
+		report(a.width, carries[a.width], "the last carry drives %s, want %s", carries[a.width], top)
+	}
+	return faults, carries
+}
+
+// verifyBit checks the full adder of bit i given its carry in, and returns its carry out
+func (a *adder) verifyBit(i int, carry string, report func(int, string, string, ...any)) string {
+	x, y, z := wireName('x', i), wireName('y', i), wireName('z', i)
+	p, okP := a.find(x, xorGate, y)
+	g, okG := a.find(x, andGate, y)
+	if !okP || !okG {
+		report(i, z, "%s and %s are not both read by an XOR and an AND gate", x, y)
+		return ""
+	}
+	for _, w := range []string{p, g} {
+		if w[0] == 'z' {
+			report(i, w, "%s is the output of %s, want an internal wire", w, a.gates[w])
+		}
+	}
+
+	d, ok := a.gates[z]
+	switch {
+	case !ok:
+		report(i, z, "%s is not driven by any gate", z)
+	case d.op != xorGate:
+		report(i, z, "%s is driven by %s, want %s XOR the carry", z, d, p)
+	case carry == "" && (d.w1 == p || d.w2 == p):
+		// Pick the carry back up from the sum gate after an earlier fault lost it
+		carry = d.w1
+		if carry == p {
+			carry = d.w2
+		}
+	case keyOf(d.w1, d.op, d.w2) == keyOf(p, xorGate, carry):
+	case d.w1 == carry || d.w2 == carry:
+		report(i, p, "%s XOR %s drives %s, but %s takes %s instead", x, y, p, z, other(d, carry))
+	case d.w1 == p || d.w2 == p:
+		report(i, carry, "the carry into bit %d is %s, but %s takes %s instead", i, carry, z, other(d, p))
+	default:
+		report(i, z, "%s is driven by %s, want %s XOR the carry %s", z, d, p, carry)
+	}
+	if sum, ok := a.find(p, xorGate, carry); ok && sum != z {
+		report(i, sum, "%s XOR the carry %s drives %s, want %s", p, carry, sum, z)
+	}
+
+	t, ok := a.find(p, andGate, carry)
+	if !ok && carry != "" {
+		report(i, p, "no AND gate combines %s with the carry %s", p, carry)
+	}
+	if next, ok := a.find(g, orGate, t); ok {
+		if next[0] == 'z' && i < a.width-1 {
+			report(i, next, "the carry out of bit %d drives %s, want an internal wire", i, next)
+		}
+		return next
+	}
+
+	// Take the carry from whichever OR gate reads one of the wires it should
+	// Take the carry from whichever OR gate reads one of the wires it should. A missing
+	// AND gate has been reported already, unless there was no carry to look for it with.
+	if next, in, ok := a.readBy(g, orGate); ok {
+		switch {
+		case t != "":
+			report(i, t, "%s AND the carry drives %s, but the carry gate %s takes %s instead", p, t, next, in)
+		case carry == "":
+			report(i, next, "the carry gate %s takes %s with no carry into bit %d", next, in, i)
+		}
+		return next
+	}
+	if next, in, ok := a.readBy(t, orGate); ok {
+		report(i, g, "%s AND %s drives %s, but the carry gate %s takes %s instead", x, y, g, next, in)
+		return next
+	}
+	if t == "" {
+		report(i, g, "no OR gate reads %s to make the carry", g)
+	} else {
+		report(i, g, "no OR gate combines %s and %s into the carry", g, t)
+	}
+	return ""
+}
+
+// other returns the input of d that is not w
+func other(d dependency, w string) string {
+	if d.w1 == w {
+		return d.w2
+	}
+	return d.w1
+}
+
+func (d dependency) String() string {
+	return d.w1 + " " + d.op + " " + d.w2
+}
+
+// confirm checks that the circuit adds correctly on the extremes and on random numbers
+func (a *adder) confirm(rng *rand.Rand) error {
+	c, err := NewCircuit(a.gates)
+	if err != nil {
+		return err
+	}
+	limit := uint64(1) << a.width
+	cases := [][2]uint64{{0, 0}, {limit - 1, 1}, {1, limit - 1}, {limit - 1, limit - 1}}
+	for range confirmTrials {
+		cases = append(cases, [2]uint64{rng.Uint64N(limit), rng.Uint64N(limit)})
+	}
+	for _, pair := range cases {
+		sum, err := c.Add(pair[0], pair[1])
+		if err != nil {
+			return err
+		}
+		if sum != pair[0]+pair[1] {
+			return fmt.Errorf("%w: %d + %d gives %d", ErrNotAdder, pair[0], pair[1], sum)
+		}
+	}
+	return nil
+}
+
+// firstFault returns the lowest bit with a fault, or width+1 if there is none
+func (a *adder) firstFault() int {
+	faults, _ := a.verify()
+	if len(faults) == 0 {
+		return a.width + 1
+	}
+	return slices.MinFunc(faults, func(f, g Fault) int { return f.Bit - g.Bit }).Bit
+}
+
+// nearBit returns the outputs of the gates of bit i: those reachable in up to three gates
+// from its inputs and its carry in, and its z wire
+func (a *adder) nearBit(i int, carry string) []string {
+	seen := map[string]bool{wireName('z', i): true}
+	frontier := []string{wireName('x', i), wireName('y', i)}
+	if carry != "" {
+		frontier = append(frontier, carry)
+		seen[carry] = true
+	}
+	for range 3 {
+		var next []string
+		for _, w := range frontier {
+			for _, out := range a.inputs[w] {
+				if !seen[out] {
+					seen[out] = true
+					next = append(next, out)
+				}
+			}
+		}
+		frontier = next
+	}
+	wires := make([]string, 0, len(seen))
+	for w := range seen {
+		if _, ok := a.gates[w]; ok {
+			wires = append(wires, w)
+		}
+	}
+	slices.Sort(wires)
+	return wires
+}
+
+// repair searches for at most maxSwaps swaps of gate outputs that make the circuit a
+// ripple-carry adder, and confirms them by simulating additions. Each swap must fix the
+// lowest faulty bit, so it exchanges a gate of that bit with any other gate.
+func (a *adder) repair(rng *rand.Rand) ([][2]string, error) {
+	outputs := make([]string, 0, len(a.gates))
+	for w := range a.gates {
+		outputs = append(outputs, w)
+	}
+	slices.Sort(outputs)
+
+	var swaps [][2]string
+	var search func() bool
+	search = func() bool {
+		faults, carries := a.verify()
+		if len(faults) == 0 {
+			return a.confirm(rng) == nil
+		}
+		if len(swaps) == maxSwaps {
+			return false
+		}
+		bit := a.firstFault()
+		for _, w1 := range a.nearBit(min(bit, a.width-1), carries[min(bit, a.width-1)]) {
+			for _, w2 := range outputs {
+				if w1 == w2 {
+					continue
+				}
+				a.swap(w1, w2)
+				if a.firstFault() > bit {
+					swaps = append(swaps, [2]string{w1, w2})
+					if search() {
+						return true
+					}
+					swaps = swaps[:len(swaps)-1]
+				}
+				a.swap(w1, w2)
+			}
+		}
+		return false
+	}
+
+	if !search() {
+		faults, _ := a.verify()
+		reasons := make([]string, len(faults))
+		for i, f := range faults {
+			reasons[i] = f.String()
+		}
+		return nil, fmt.Errorf("%w with %d swaps:\n%s", ErrNoRepair, maxSwaps, strings.Join(reasons, "\n"))
+	}
+	return swaps, nil
+}
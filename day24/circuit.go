@@ -0,0 +1,232 @@
+package day24
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"aoc2024/utility"
+	"aoc2024/utility/container"
+)
+
+var (
+	ErrCycle         = errors.New("circuit has a cycle")
+	ErrUndefinedWire = errors.New("wire has no value")
+	ErrNotInput      = errors.New("wire is driven by a gate")
+)
+
+// circuitGate is a gate with its wires replaced by their indices
+type circuitGate struct {
+	in1, in2, out int
+	op            string
+}
+
+// Circuit is a netlist of gates sorted so that every gate comes after the gates driving
+// its inputs. Wires that no gate drives are its inputs, which can be set again and again
+// between runs.
+type Circuit struct {
+	names  []string
+	index  map[string]int
+	gates  []circuitGate // in evaluation order
+	inputs []bool        // whether each wire is an input
+
+	values  []int8
+	set     []bool // whether each input has been given a value
+	changed []bool // whether each wire changed since the last run
+	fresh   bool   // whether the circuit has never run
+
+	evaluations int
+}
+
+// NewCircuit sorts the gates into evaluation order, failing with ErrCycle if a wire
+// depends on itself. Any inputs given are added to the circuit even if no gate reads them.
+func NewCircuit(dependencies map[string]dependency, inputs ...string) (*Circuit, error) {
+	c := &Circuit{index: make(map[string]int), fresh: true}
+	id := func(w string) int {
+		i, ok := c.index[w]
+		if !ok {
+			i = len(c.names)
+			c.index[w] = i
+			c.names = append(c.names, w)
+		}
+		return i
+	}
+
+	// Number the wires in name order so that evaluation order does not depend on map order
+	outs := slices.Sorted(maps.Keys(dependencies))
+	gates := make([]circuitGate, len(outs))
+	for i, out := range outs {
+		d := dependencies[out]
+		gates[i] = circuitGate{in1: id(d.w1), in2: id(d.w2), out: id(out), op: d.op}
+	}
+	for _, w := range inputs {
+		id(w)
+	}
+	n := len(c.names)
+
+	// Kahn's algorithm over the gates, indexed by the wire they drive
+	driver := make([]int, n)
+	for i := range driver {
+		driver[i] = -1
+	}
+	for i, g := range gates {
+		driver[g.out] = i
+	}
+	pending := make([]int, len(gates))
+	readers := make([][]int, n)
+	var ready container.Deque[int]
+	for i, g := range gates {
+		for _, in := range []int{g.in1, g.in2} {
+			if driver[in] != -1 {
+				pending[i]++
+				readers[in] = append(readers[in], i)
+			}
+		}
+		if pending[i] == 0 {
+			ready.PushBack(i)
+		}
+	}
+	for ready.Len() > 0 {
+		i, _ := ready.PopFront()
+		c.gates = append(c.gates, gates[i])
+		for _, r := range readers[gates[i].out] {
+			if pending[r]--; pending[r] == 0 {
+				ready.PushBack(r)
+			}
+		}
+	}
+	if len(c.gates) != len(gates) {
+		for i, p := range pending {
+			if p > 0 {
+				return nil, fmt.Errorf("%w through %s", ErrCycle, c.names[gates[i].out])
+			}
+		}
+	}
+
+	c.inputs = make([]bool, n)
+	for i := range c.inputs {
+		c.inputs[i] = driver[i] == -1
+	}
+	c.values = make([]int8, n)
+	c.set = make([]bool, n)
+	c.changed = make([]bool, n)
+	return c, nil
+}
+
+// Set gives an input wire a value for the next run
+func (c *Circuit) Set(wire string, v int8) error {
+	i, ok := c.index[wire]
+	switch {
+	case !ok:
+		return fmt.Errorf("%w: %s is not in the circuit", ErrUndefinedWire, wire)
+	case !c.inputs[i]:
+		return fmt.Errorf("%w: %s", ErrNotInput, wire)
+	}
+	if !c.set[i] || c.values[i] != v {
+		c.changed[i] = true
+	}
+	c.values[i], c.set[i] = v, true
+	return nil
+}
+
+// SetNumber sets the input wires named prefix followed by a bit number, such as x00 and
+// x01, to the bits of n. Bits with no wire are ignored.
+func (c *Circuit) SetNumber(prefix byte, n uint64) {
+	for i, name := range c.names {
+		if bit, ok := bitOf(name, prefix); ok && c.inputs[i] {
+			var v int8
+			if bit < 64 {
+				v = int8(n >> bit & 1) //nolint:gosec // a single bit
+			}
+			_ = c.Set(name, v)
+		}
+	}
+}
+
+// Run evaluates the gates whose inputs changed since the last run. It fails with
+// ErrUndefinedWire if an input read by a gate has never been set.
+func (c *Circuit) Run() error {
+	for _, g := range c.gates {
+		for _, in := range []int{g.in1, g.in2} {
+			if c.inputs[in] && !c.set[in] {
+				return fmt.Errorf("%w: %s", ErrUndefinedWire, c.names[in])
+			}
+		}
+	}
+
+	c.evaluations = 0
+	for _, g := range c.gates {
+		if !c.fresh && !c.changed[g.in1] && !c.changed[g.in2] {
+			continue
+		}
+		c.evaluations++
+		a, b := c.values[g.in1], c.values[g.in2]
+		var v int8
+		switch g.op {
+		case andGate:
+			v = a & b
+		case orGate:
+			v = a | b
+		case xorGate:
+			v = a ^ b
+		}
+		if c.fresh || v != c.values[g.out] {
+			c.values[g.out] = v
+			c.changed[g.out] = true
+		}
+	}
+	clear(c.changed)
+	c.fresh = false
+	return nil
+}
+
+// Evaluations returns how many gates the last run evaluated
+func (c *Circuit) Evaluations() int {
+	return c.evaluations
+}
+
+// Value returns the value of a wire after the last run
+func (c *Circuit) Value(wire string) (int8, error) {
+	i, ok := c.index[wire]
+	if !ok || (c.inputs[i] && !c.set[i]) {
+		return 0, fmt.Errorf("%w: %s", ErrUndefinedWire, wire)
+	}
+	return c.values[i], nil
+}
+
+// Number reads the wires named prefix followed by a bit number as a binary number
+func (c *Circuit) Number(prefix byte) (uint64, error) {
+	var n uint64
+	for i, name := range c.names {
+		bit, ok := bitOf(name, prefix)
+		if !ok || c.values[i] == 0 {
+			continue
+		}
+		if bit >= 64 {
+			return 0, fmt.Errorf("%w: %s", utility.ErrOverflow, name)
+		}
+		n |= 1 << bit
+	}
+	return n, nil
+}
+
+// Add sets x and y, runs the circuit and returns z
+func (c *Circuit) Add(x, y uint64) (uint64, error) {
+	c.SetNumber('x', x)
+	c.SetNumber('y', y)
+	if err := c.Run(); err != nil {
+		return 0, err
+	}
+	return c.Number('z')
+}
+
+// bitOf returns the bit number of a wire named prefix followed by digits
+func bitOf(wire string, prefix byte) (int, bool) {
+	if len(wire) < 2 || wire[0] != prefix {
+		return 0, false
+	}
+	bit, err := strconv.Atoi(wire[1:])
+	return bit, err == nil && bit >= 0
+}
@@ -0,0 +1,152 @@
+package day24
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+var (
+	instrRegex     = regexp.MustCompile(`^([a-z0-9]+) ([A-Z]+) ([a-z0-9]+) -> ([a-z0-9]+)$`)
+	wireValueRegex = regexp.MustCompile(`^([a-zA-Z0-9]+): ([01])$`)
+)
+
+const (
+	andGate = "AND"
+	orGate  = "OR"
+	xorGate = "XOR"
+)
+
+// dependency represents a logical gate operation
+type dependency struct {
+	w1, w2 string
+	op     string
+}
+
+var (
+	ErrInvalidFormat    = errors.New("invalid input format: want wire values, a blank line and gates")
+	ErrInvalidWireValue = errors.New("invalid wire value: want name: 0 or 1")
+	ErrInvalidGate      = errors.New("invalid gate: want a OP b -> c")
+	ErrInvalidOperation = errors.New("invalid operation: want AND, OR or XOR")
+)
+
+// wireValue is an initial value given to an input wire
+type wireValue struct {
+	wire  string
+	value int8
+}
+
+// gate is a gate's output wire and the operation driving it
+type gate struct {
+	out string
+	dep dependency
+}
+
+func parseWireValue(line string) (wireValue, error) {
+	matches := wireValueRegex.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return wireValue{}, ErrInvalidWireValue
+	}
+	return wireValue{matches[1], int8(matches[2][0] - '0')}, nil
+}
+
+func parseGate(line string) (gate, error) {
+	matches := instrRegex.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return gate{}, ErrInvalidGate
+	}
+	op := matches[2]
+	if op != andGate && op != orGate && op != xorGate {
+		return gate{}, fmt.Errorf("%w: %s", ErrInvalidOperation, op)
+	}
+	return gate{out: matches[4], dep: dependency{w1: matches[1], w2: matches[3], op: op}}, nil
+}
+
+func parseInput(input []string) (map[string]int8, map[string]dependency, error) {
+	sections := parse.Sections(input)
+	if len(sections) != 2 {
+		return nil, nil, ErrInvalidFormat
+	}
+
+	values, err := parse.Records(sections[0], parseWireValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	gates, err := parse.Records(sections[1], parseGate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value := make(map[string]int8, len(values))
+	for _, v := range values {
+		value[v.wire] = v.value
+	}
+	dependencies := make(map[string]dependency, len(gates))
+	for _, g := range gates {
+		dependencies[g.out] = g.dep
+	}
+	return value, dependencies, nil
+}
+
+func partOne(value map[string]int8, dependencies map[string]dependency) (uint64, error) {
+	c, err := NewCircuit(dependencies, slices.Sorted(maps.Keys(value))...)
+	if err != nil {
+		return 0, err
+	}
+	for w, v := range value {
+		if err := c.Set(w, v); err != nil {
+			return 0, err
+		}
+	}
+	if err := c.Run(); err != nil {
+		return 0, err
+	}
+	return c.Number('z')
+}
+
+// partTwo finds the swapped gate outputs that stop the circuit adding, sorted and joined
+// with commas
+func partTwo(dependencies map[string]dependency) (string, error) {
+	swaps, err := repairAdder(dependencies)
+	if err != nil {
+		return "", err
+	}
+
+	wires := make([]string, 0, 2*len(swaps))
+	for _, s := range swaps {
+		wires = append(wires, s[0], s[1])
+	}
+	sort.Strings(wires)
+	return strings.Join(wires, ","), nil
+}
+
+// Solver solves day 24
+type Solver struct{}
+
+func init() {
+	registry.Register(24, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	value, dependencies, err := parseInput(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input: %w", err)
+	}
+	return partOne(value, dependencies)
+}
+
+// Part2 solves the second part of the puzzle
+func (Solver) Part2(input []string) (any, error) {
+	_, dependencies, err := parseInput(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input: %w", err)
+	}
+	return partTwo(dependencies)
+}
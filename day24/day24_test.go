@@ -0,0 +1,344 @@
+package day24
+
+import (
+	"aoc2024/utility"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPartOne(t *testing.T) {
+	tests := []struct {
+		file string
+		want uint64
+	}{
+		{file: "test", want: 4},
+		{file: "test2", want: 2024},
+	}
+
+	for _, tt := range tests {
+		input, err := utility.ParseTextFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, dependencies, err := parseInput(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := partOne(value, dependencies); err != nil || got != tt.want {
+			t.Errorf("%s: partOne() = %v, %v, want %v", tt.file, got, err, tt.want)
+		}
+	}
+}
+
+func TestPartOneUnusedInputs(t *testing.T) {
+	// Initial values for wires no gate reads are accepted, and z wires among them count
+	value, dependencies, err := parseInput([]string{"x00: 1", "y00: 0", "x01: 1", "z03: 1", "", "x00 XOR y00 -> z00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := partOne(value, dependencies); err != nil || got != 9 {
+		t.Errorf("partOne() = %d, %v, want 9", got, err)
+	}
+}
+
+// rippleCarryAdder returns the puzzle input for a correct adder of two bits-wide numbers
+// x and y, with the outputs of each pair in swaps exchanged. Bit i uses the wires
+// pNN (x^y), gNN (x&y), tNN (p&carry) and cNN (carry out); the last carry is the top z wire.
+func rippleCarryAdder(bits int, x, y uint64, swaps ...[2]string) []string {
+	var input []string
+	for i := range bits {
+		input = append(input, fmt.Sprintf("x%02d: %d", i, x>>i&1))
+	}
+	for i := range bits {
+		input = append(input, fmt.Sprintf("y%02d: %d", i, y>>i&1))
+	}
+	input = append(input, "")
+
+	rename := make(map[string]string)
+	for _, s := range swaps {
+		rename[s[0]], rename[s[1]] = s[1], s[0]
+	}
+	gate := func(w1, op, w2, out string) {
+		if r, ok := rename[out]; ok {
+			out = r
+		}
+		input = append(input, fmt.Sprintf("%s %s %s -> %s", w1, op, w2, out))
+	}
+
+	carry := func(i int) string {
+		if i == bits-1 {
+			return fmt.Sprintf("z%02d", bits)
+		}
+		return fmt.Sprintf("c%02d", i)
+	}
+
+	gate("x00", xorGate, "y00", "z00")
+	gate("x00", andGate, "y00", carry(0))
+	for i := 1; i < bits; i++ {
+		p, g, tw := fmt.Sprintf("p%02d", i), fmt.Sprintf("g%02d", i), fmt.Sprintf("t%02d", i)
+		gate(fmt.Sprintf("x%02d", i), xorGate, fmt.Sprintf("y%02d", i), p)
+		gate(fmt.Sprintf("x%02d", i), andGate, fmt.Sprintf("y%02d", i), g)
+		gate(p, xorGate, carry(i-1), fmt.Sprintf("z%02d", i))
+		gate(p, andGate, carry(i-1), tw)
+		gate(g, orGate, tw, carry(i))
+	}
+	return input
+}
+
+func TestPartOneAdds(t *testing.T) {
+	tests := []struct {
+		x, y uint64
+	}{
+		{x: 0, y: 0},
+		{x: 1, y: 1},
+		{x: 1<<45 - 1, y: 1},
+		{x: 123456789012, y: 987654321098},
+	}
+
+	for _, tt := range tests {
+		value, dependencies, err := parseInput(rippleCarryAdder(45, tt.x, tt.y))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := partOne(value, dependencies); err != nil || got != tt.x+tt.y {
+			t.Errorf("partOne(%d + %d) = %d, %v, want %d", tt.x, tt.y, got, err, tt.x+tt.y)
+		}
+	}
+}
+
+func TestPartTwo(t *testing.T) {
+	tests := []struct {
+		name  string
+		swaps [][2]string
+		want  string
+	}{
+		{name: "correct adder", want: ""},
+		{
+			name:  "four swaps",
+			swaps: [][2]string{{"z07", "c07"}, {"z15", "t15"}, {"p22", "g22"}, {"z30", "c30"}},
+			want:  "c07,c30,g22,p22,t15,z07,z15,z30",
+		},
+	}
+
+	for _, tt := range tests {
+		_, dependencies, err := parseInput(rippleCarryAdder(45, 0, 0, tt.swaps...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := partTwo(dependencies); err != nil || got != tt.want {
+			t.Errorf("%s: partTwo() = %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		want     error
+		wantLine int
+	}{
+		{name: "no gates", input: []string{"x00: 1"}, want: ErrInvalidFormat},
+		{name: "bad value", input: []string{"x00: 1", "y00: 2", "", "x00 AND y00 -> z00"}, want: ErrInvalidWireValue,
+			wantLine: 2},
+		{name: "bad gate", input: []string{"x00: 1", "", "x00 AND y00 z00"}, want: ErrInvalidGate, wantLine: 3},
+		{name: "bad operation", input: []string{"x00: 1", "", "x00 NAND y00 -> z00"}, want: ErrInvalidOperation,
+			wantLine: 3},
+	}
+
+	for _, tt := range tests {
+		_, _, err := parseInput(tt.input)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: parseInput() error = %v, want %v", tt.name, err, tt.want)
+		}
+		var lineErr *parse.LineError
+		if tt.wantLine != 0 && (!errors.As(err, &lineErr) || lineErr.Line != tt.wantLine) {
+			t.Errorf("%s: parseInput() error = %v, want it on line %d", tt.name, err, tt.wantLine)
+		}
+	}
+}
+
+func TestVerifyAdder(t *testing.T) {
+	tests := []struct {
+		name     string
+		bits     int
+		swaps    [][2]string
+		wantBits []int
+		wantWire string
+	}{
+		{name: "correct", bits: 45},
+		{name: "one bit", bits: 1},
+		{name: "sum and carry", bits: 45, swaps: [][2]string{{"z07", "c07"}}, wantBits: []int{7, 8}, wantWire: "z07"},
+		{name: "half and full sum", bits: 12, swaps: [][2]string{{"p05", "g05"}}, wantBits: []int{5}, wantWire: "p05"},
+		{name: "carry into sum", bits: 12, swaps: [][2]string{{"z11", "t11"}}, wantBits: []int{11}, wantWire: "z11"},
+	}
+
+	for _, tt := range tests {
+		_, dependencies, err := parseInput(rippleCarryAdder(tt.bits, 0, 0, tt.swaps...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := newAdder(dependencies)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.width != tt.bits {
+			t.Errorf("%s: width = %d, want %d", tt.name, a.width, tt.bits)
+		}
+
+		faults, _ := a.verify()
+		var bits []int
+		wires := make(map[string]bool)
+		for _, f := range faults {
+			if !slices.Contains(bits, f.Bit) {
+				bits = append(bits, f.Bit)
+			}
+			wires[f.Gate] = true
+		}
+		if !slices.Equal(bits, tt.wantBits) || (tt.wantWire != "" && !wires[tt.wantWire]) {
+			t.Errorf("%s: verify() = %v, want faults at bits %v including %s", tt.name, faults, tt.wantBits, tt.wantWire)
+		}
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	if faults, err := Diagnose(rippleCarryAdder(12, 0, 0)); err != nil || len(faults) != 0 {
+		t.Errorf("Diagnose(correct adder) = %v, %v, want no faults", faults, err)
+	}
+
+	// Swapping bit 7's sum with its carry out breaks bit 7 and the carry into bit 8
+	faults, err := Diagnose(rippleCarryAdder(12, 0, 0, [2]string{"z07", "c07"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Fault{
+		{Bit: 7, Gate: "z07", Reason: "z07 is driven by g07 OR t07, want p07 XOR the carry"},
+		{Bit: 7, Gate: "c07", Reason: "p07 XOR the carry c06 drives c07, want z07"},
+		{Bit: 7, Gate: "z07", Reason: "the carry out of bit 7 drives z07, want an internal wire"},
+		{Bit: 8, Gate: "z07", Reason: "the carry into bit 8 is z07, but z08 takes c07 instead"},
+		{Bit: 8, Gate: "p08", Reason: "no AND gate combines p08 with the carry z07"},
+	}
+	if !slices.Equal(faults, want) {
+		t.Errorf("Diagnose() =\n%v\nwant\n%v", faults, want)
+	}
+
+	swaps, err := Repair(rippleCarryAdder(12, 0, 0, [2]string{"z07", "c07"}))
+	if err != nil || len(swaps) != 1 || !slices.Contains(swaps[0][:], "z07") || !slices.Contains(swaps[0][:], "c07") {
+		t.Errorf("Repair() = %v, %v, want z07 and c07 swapped", swaps, err)
+	}
+}
+
+func TestRepairRandomSwaps(t *testing.T) {
+	// Swapping the two inputs of a carry's OR gate leaves a working adder, so g and t
+	// are never swapped with each other
+	pairs := [][2]byte{{'z', 'c'}, {'z', 't'}, {'z', 'g'}, {'p', 'g'}, {'p', 't'}, {'c', 'p'}, {'c', 'g'}}
+	rng := rand.New(rand.NewPCG(24, 12))
+	for range 5 {
+		var swaps [][2]string
+		var want []string
+		for _, bit := range rng.Perm(21)[:4] {
+			bit = 2*bit + 1 // keep swapped bits apart and below the top carry
+			pair := pairs[rng.IntN(len(pairs))]
+			swaps = append(swaps, [2]string{fmt.Sprintf("%c%02d", pair[0], bit), fmt.Sprintf("%c%02d", pair[1], bit)})
+			want = append(want, swaps[len(swaps)-1][:]...)
+		}
+		slices.Sort(want)
+
+		_, dependencies, err := parseInput(rippleCarryAdder(45, 0, 0, swaps...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := partTwo(dependencies); err != nil || got != strings.Join(want, ",") {
+			t.Errorf("swaps %v: partTwo() = %q, %v, want %q", swaps, got, err, strings.Join(want, ","))
+		}
+	}
+}
+
+func TestAdderErrors(t *testing.T) {
+	_, dependencies, err := parseInput([]string{"x00: 1", "x01: 1", "", "x00 XOR x01 -> z00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := partTwo(dependencies); !errors.Is(err, ErrNotAdder) {
+		t.Errorf("partTwo() error = %v, want %v", err, ErrNotAdder)
+	}
+
+	// Five swaps are one more than the search allows
+	swaps := [][2]string{{"z03", "c03"}, {"z09", "c09"}, {"z15", "c15"}, {"z21", "c21"}, {"z27", "c27"}}
+	_, dependencies, err = parseInput(rippleCarryAdder(45, 0, 0, swaps...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := partTwo(dependencies); !errors.Is(err, ErrNoRepair) {
+		t.Errorf("partTwo() error = %v, want %v", err, ErrNoRepair)
+	}
+}
+
+func TestCircuit(t *testing.T) {
+	_, dependencies, err := parseInput(rippleCarryAdder(45, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCircuit(dependencies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Run(); !errors.Is(err, ErrUndefinedWire) {
+		t.Errorf("Run() before setting inputs error = %v, want %v", err, ErrUndefinedWire)
+	}
+
+	rng := rand.New(rand.NewPCG(24, 25))
+	for i := range 50 {
+		x, y := rng.Uint64N(1<<45), rng.Uint64N(1<<45)
+		if got, err := c.Add(x, y); err != nil || got != x+y {
+			t.Fatalf("Add(%d, %d) = %d, %v, want %d", x, y, got, err, x+y)
+		}
+		if i == 0 && c.Evaluations() != len(dependencies) {
+			t.Errorf("first run evaluated %d gates, want all %d", c.Evaluations(), len(dependencies))
+		}
+	}
+
+	// Changing one input only evaluates the gates downstream of it that change
+	x := uint64(1<<45 - 1)
+	if _, err := c.Add(x, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := c.Add(x, 1); err != nil || got != x+1 {
+		t.Errorf("Add(%d, 1) = %d, %v, want %d", x, got, err, x+1)
+	}
+	if got, err := c.Add(x, 1); err != nil || got != x+1 || c.Evaluations() != 0 {
+		t.Errorf("repeated Add() = %d, %v after %d evaluations, want %d after none", got, err, c.Evaluations(), x+1)
+	}
+	if err := c.Set("z00", 1); !errors.Is(err, ErrNotInput) {
+		t.Errorf("Set(z00) error = %v, want %v", err, ErrNotInput)
+	}
+
+	// Swapping a sum bit with the half sum that feeds it makes the sum gate read itself
+	_, dependencies, err = parseInput(rippleCarryAdder(8, 0, 0, [2]string{"p05", "z05"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCircuit(dependencies); !errors.Is(err, ErrCycle) {
+		t.Errorf("NewCircuit() error = %v, want %v", err, ErrCycle)
+	}
+
+	_, dependencies, err = parseInput([]string{"x00: 1", "", "x00 AND y00 -> z00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err = NewCircuit(dependencies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set("x00", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Run(); !errors.Is(err, ErrUndefinedWire) {
+		t.Errorf("Run() with y00 unset error = %v, want %v", err, ErrUndefinedWire)
+	}
+}
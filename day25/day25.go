@@ -0,0 +1,115 @@
+package day25
+
+import (
+	"aoc2024/registry"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+	"aoc2024/utility/parse"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSchematic is returned for a schematic whose top and bottom rows are not one
+// filled and one empty row
+var ErrInvalidSchematic = errors.New("invalid schematic: want a lock or a key")
+
+// parseInput reads the schematics and returns the pin heights of the locks and the keys
+func parseInput(input []string) ([][]int, [][]int, error) {
+	var locks, keys [][]int
+
+	schematics, err := parse.Blocks(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, schematic := range schematics {
+		switch {
+		case isFilledRow(schematic, 0) && !isFilledRow(schematic, schematic.Height()-1):
+			locks = append(locks, processSchema(schematic))
+		case !isFilledRow(schematic, 0) && isFilledRow(schematic, schematic.Height()-1):
+			keys = append(keys, processSchema(schematic))
+		default:
+			return nil, nil, fmt.Errorf("%w: schematic %d", ErrInvalidSchematic, i+1)
+		}
+	}
+
+	return locks, keys, nil
+}
+
+// isFilledRow reports whether every cell of row y is '#'
+func isFilledRow(schematic *grid.Grid[byte], y int) bool {
+	for x := range schematic.Width() {
+		if schematic.At(geom.Point{X: x, Y: y}) != '#' {
+			return false
+		}
+	}
+	return true
+}
+
+// processSchema returns the height of each column's pin, not counting the filled row
+func processSchema(schematic *grid.Grid[byte]) []int {
+	heights := make([]int, schematic.Width())
+
+	// Count '#' characters in each column
+	for p, c := range schematic.All() {
+		if c == '#' {
+			heights[p.X]++
+		}
+	}
+
+	// Subtract 1 from each height to account for the top/bottom row
+	for i := range heights {
+		heights[i]--
+	}
+
+	return heights
+}
+
+func countValidPairs(locks, keys [][]int) int {
+	count := 0
+	for _, lock := range locks {
+		for _, key := range keys {
+			check := make([]int, 5)
+			for i := range lock {
+				check[i] = lock[i] + key[i]
+			}
+
+			isValid := true
+			for i := range check {
+				if check[i] > 5 {
+					isValid = false
+					break
+				}
+			}
+
+			if isValid {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func part1(input []string) (int, error) {
+	locks, keys, err := parseInput(input)
+	if err != nil {
+		return 0, err
+	}
+	return countValidPairs(locks, keys), nil
+}
+
+// Solver solves day 25
+type Solver struct{}
+
+func init() {
+	registry.Register(25, Solver{})
+}
+
+// Part1 solves the first part of the puzzle
+func (Solver) Part1(input []string) (any, error) {
+	return part1(input)
+}
+
+// Part2 reports that day 25 only has a single puzzle
+func (Solver) Part2(_ []string) (any, error) {
+	return nil, registry.ErrNoPart
+}
@@ -0,0 +1,47 @@
+package day25
+
+import (
+	"aoc2024/utility"
+	"aoc2024/utility/grid"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	input, err := utility.ParseTextFile("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locks, keys, err := parseInput(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLocks := [][]int{{0, 5, 3, 4, 3}, {1, 2, 0, 5, 3}}
+	wantKeys := [][]int{{5, 0, 2, 1, 3}, {4, 3, 4, 0, 2}, {3, 0, 2, 0, 1}}
+	if !reflect.DeepEqual(locks, wantLocks) || !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("parseInput() = %v, %v, want %v, %v", locks, keys, wantLocks, wantKeys)
+	}
+
+	if got, err := part1(input); err != nil || got != 3 {
+		t.Errorf("part1() = %v, %v, want 3", got, err)
+	}
+}
+
+func TestInvalidSchematic(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  error
+	}{
+		{name: "open both ends", input: []string{".#", "##", ".."}, want: ErrInvalidSchematic},
+		{name: "ragged", input: []string{"##", "#", ".."}, want: grid.ErrRagged},
+	}
+
+	for _, tt := range tests {
+		if _, err := part1(tt.input); !errors.Is(err, tt.want) {
+			t.Errorf("%s: part1() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Package days links every day's solver into the registry. Import it for its side effects.
+package days
+
+import (
+	_ "aoc2024/day01"
+	_ "aoc2024/day02"
+	_ "aoc2024/day03"
+	_ "aoc2024/day04"
+	_ "aoc2024/day05"
+	_ "aoc2024/day06"
+	_ "aoc2024/day07"
+	_ "aoc2024/day08"
+	_ "aoc2024/day09"
+	_ "aoc2024/day10"
+	_ "aoc2024/day11"
+	_ "aoc2024/day12"
+	_ "aoc2024/day13"
+	_ "aoc2024/day14"
+	_ "aoc2024/day15"
+	_ "aoc2024/day16"
+	_ "aoc2024/day17"
+	_ "aoc2024/day18"
+	_ "aoc2024/day19"
+	_ "aoc2024/day20"
+	_ "aoc2024/day21"
+	_ "aoc2024/day22"
+	_ "aoc2024/day23"
+	_ "aoc2024/day24"
+	_ "aoc2024/day25"
+)
@@ -0,0 +1,59 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Solver solves both parts of a single day's puzzle from its input lines.
+// Malformed input is reported through the returned error rather than by
+// terminating the process, so one bad day does not stop the others.
+type Solver interface {
+	Part1(input []string) (any, error)
+	Part2(input []string) (any, error)
+}
+
+// ErrNoPart is returned by a solver for a part the day does not have
+var ErrNoPart = errors.New("puzzle has no such part")
+
+var (
+	mu      sync.RWMutex
+	solvers = make(map[int]Solver)
+)
+
+// Register makes a solver available for the given day.
+// It panics if the day is out of range or already registered, since both
+// are programming errors that should surface at init time.
+func Register(day int, s Solver) {
+	if day < 1 || day > 25 {
+		panic(fmt.Sprintf("registry: invalid day %d", day))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, exists := solvers[day]; exists {
+		panic(fmt.Sprintf("registry: day %d registered twice", day))
+	}
+	solvers[day] = s
+}
+
+// Lookup returns the solver registered for the given day
+func Lookup(day int) (Solver, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	s, ok := solvers[day]
+	return s, ok
+}
+
+// Days returns all registered days in ascending order
+func Days() []int {
+	mu.RLock()
+	defer mu.RUnlock()
+	days := make([]int, 0, len(solvers))
+	for day := range solvers {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
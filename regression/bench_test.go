@@ -0,0 +1,44 @@
+package regression
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"aoc2024/registry"
+	"aoc2024/utility"
+)
+
+// BenchmarkSolvers measures each part of every day on its real input.
+// Run a single part with e.g. -bench 'Solvers/day17/part2'. Days without a local input are skipped.
+func BenchmarkSolvers(b *testing.B) {
+	dir := inputDir()
+	for _, day := range registry.Days() {
+		b.Run(fmt.Sprintf("day%02d", day), func(b *testing.B) {
+			input, err := utility.ReadDayInput(dir, day)
+			if errors.Is(err, os.ErrNotExist) {
+				b.Skipf("no input at %s", utility.InputPath(dir, day))
+			}
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			s, _ := registry.Lookup(day)
+			for part, solve := range []func([]string) (any, error){s.Part1, s.Part2} {
+				b.Run(fmt.Sprintf("part%d", part+1), func(b *testing.B) {
+					if _, err := solve(input); errors.Is(err, registry.ErrNoPart) {
+						b.Skip("no second part")
+					}
+					b.ReportAllocs()
+					b.ResetTimer()
+					for range b.N {
+						if _, err := solve(input); err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+			}
+		})
+	}
+}
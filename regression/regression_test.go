@@ -0,0 +1,62 @@
+package regression
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "aoc2024/days"
+	"aoc2024/registry"
+	"aoc2024/site"
+	"aoc2024/utility"
+)
+
+// inputDir returns the inputs directory: AOC_INPUT_DIR if set, otherwise the
+// default inputs directory at the repository root
+func inputDir() string {
+	if dir := os.Getenv("AOC_INPUT_DIR"); dir != "" {
+		return dir
+	}
+	return filepath.Join("..", utility.DefaultInputDir)
+}
+
+// TestRecordedAnswers checks every day against the answers recorded in answers.json.
+// Days without a local input, and parts without a recorded answer, are skipped.
+func TestRecordedAnswers(t *testing.T) {
+	dir := inputDir()
+	book, err := site.LoadAnswers(site.AnswersPath(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, day := range registry.Days() {
+		t.Run(fmt.Sprintf("day%02d", day), func(t *testing.T) {
+			input, err := utility.ReadDayInput(dir, day)
+			if errors.Is(err, os.ErrNotExist) {
+				t.Skipf("no input at %s", utility.InputPath(dir, day))
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s, _ := registry.Lookup(day)
+			for part, solve := range []func([]string) (any, error){s.Part1, s.Part2} {
+				t.Run(fmt.Sprintf("part%d", part+1), func(t *testing.T) {
+					want, ok := book.Expected(day, part+1)
+					if !ok {
+						t.Skip("no recorded answer")
+					}
+					got, err := solve(input)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if fmt.Sprint(got) != want {
+						t.Errorf("answer = %v, want %s", got, want)
+					}
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package runner
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Format selects how results are written
+type Format string
+
+const (
+	Text Format = "text"
+	JSON Format = "json"
+	CSV  Format = "csv"
+)
+
+// ParseFormat checks that s names a supported format
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case Text, JSON, CSV:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown format %q: must be text, json or csv", s)
+	}
+}
+
+// Writer writes results one at a time. Close must be called once all results are written.
+type Writer interface {
+	Write(r Result) error
+	Close() error
+}
+
+// NewWriter returns a writer for the format. Text output goes to out for answers and to
+// errOut for errors; the structured formats write everything to out.
+func NewWriter(f Format, out, errOut io.Writer) Writer {
+	switch f {
+	case JSON:
+		return &jsonWriter{out: out, records: []record{}}
+	case CSV:
+		return &csvWriter{out: csv.NewWriter(out)}
+	default:
+		return textWriter{out: out, errOut: errOut}
+	}
+}
+
+// record is the structured form of a result
+type record struct {
+	Day        int    `json:"day"`
+	Part       int    `json:"part"`
+	Status     Status `json:"status"`
+	Answer     string `json:"answer"`
+	DurationNs int64  `json:"duration_ns"`
+	Error      string `json:"error,omitempty"`
+}
+
+func newRecord(r Result) record {
+	rec := record{
+		Day: r.Day, Part: r.Part, Status: r.Status(), Answer: r.Answer, DurationNs: r.Duration.Nanoseconds(),
+	}
+	if r.Err != nil {
+		rec.Error = r.Err.Error()
+	}
+	return rec
+}
+
+// textWriter prints the human-readable lines of "aoc run"
+type textWriter struct {
+	out, errOut io.Writer
+}
+
+func (w textWriter) Write(r Result) error {
+	elapsed := r.Duration.Round(time.Microsecond)
+	switch r.Status() {
+	case TimedOut:
+		_, err := fmt.Fprintf(w.errOut, "Day %02d Part %d: timed out after %s\n", r.Day, r.Part, elapsed)
+		return err
+	case Failed:
+		_, err := fmt.Fprintf(w.errOut, "Day %02d Part %d: %v\n", r.Day, r.Part, r.Err)
+		return err
+	}
+	_, err := fmt.Fprintf(w.out, "Day %02d Part %d: %s (%s)\n", r.Day, r.Part, r.Answer, elapsed)
+	return err
+}
+
+func (textWriter) Close() error {
+	return nil
+}
+
+// jsonWriter collects the results and writes them as a single JSON array
+type jsonWriter struct {
+	out     io.Writer
+	records []record
+}
+
+func (w *jsonWriter) Write(r Result) error {
+	w.records = append(w.records, newRecord(r))
+	return nil
+}
+
+func (w *jsonWriter) Close() error {
+	enc := json.NewEncoder(w.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(w.records)
+}
+
+// csvWriter writes a header row followed by one row per result
+type csvWriter struct {
+	out         *csv.Writer
+	wroteHeader bool
+}
+
+func (w *csvWriter) header() error {
+	if w.wroteHeader {
+		return nil
+	}
+	w.wroteHeader = true
+	return w.out.Write([]string{"day", "part", "status", "answer", "duration_ns", "error"})
+}
+
+func (w *csvWriter) Write(r Result) error {
+	if err := w.header(); err != nil {
+		return err
+	}
+	rec := newRecord(r)
+	return w.out.Write([]string{
+		strconv.Itoa(rec.Day), strconv.Itoa(rec.Part), string(rec.Status), rec.Answer,
+		strconv.FormatInt(rec.DurationNs, 10), rec.Error,
+	})
+}
+
+func (w *csvWriter) Close() error {
+	if err := w.header(); err != nil {
+		return err
+	}
+	w.out.Flush()
+	return w.out.Error()
+}
@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriters(t *testing.T) {
+	results := []Result{
+		{Day: 3, Part: 1, Answer: "161", Duration: 1500 * time.Microsecond},
+		{Day: 3, Part: 2, Err: errors.New("bad, input"), Duration: 2 * time.Millisecond},
+		{Day: 14, Part: 2, Err: context.DeadlineExceeded, Duration: 10 * time.Second},
+	}
+
+	tests := []struct {
+		format            Format
+		wantOut, wantErrs string
+	}{
+		{
+			format:   Text,
+			wantOut:  "Day 03 Part 1: 161 (1.5ms)\n",
+			wantErrs: "Day 03 Part 2: bad, input\nDay 14 Part 2: timed out after 10s\n",
+		},
+		{
+			format: JSON,
+			wantOut: `[
+  {
+    "day": 3,
+    "part": 1,
+    "status": "ok",
+    "answer": "161",
+    "duration_ns": 1500000
+  },
+  {
+    "day": 3,
+    "part": 2,
+    "status": "error",
+    "answer": "",
+    "duration_ns": 2000000,
+    "error": "bad, input"
+  },
+  {
+    "day": 14,
+    "part": 2,
+    "status": "timeout",
+    "answer": "",
+    "duration_ns": 10000000000,
+    "error": "context deadline exceeded"
+  }
+]
+`,
+		},
+		{
+			format: CSV,
+			wantOut: "day,part,status,answer,duration_ns,error\n3,1,ok,161,1500000,\n3,2,error,,2000000,\"bad, input\"\n" +
+				"14,2,timeout,,10000000000,context deadline exceeded\n",
+		},
+	}
+
+	for _, tt := range tests {
+		var out, errs strings.Builder
+		w := NewWriter(tt.format, &out, &errs)
+		for _, r := range results {
+			if err := w.Write(r); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != tt.wantOut || errs.String() != tt.wantErrs {
+			t.Errorf("%s output = %q, %q, want %q, %q", tt.format, out.String(), errs.String(), tt.wantOut, tt.wantErrs)
+		}
+	}
+}
+
+func TestEmptyOutput(t *testing.T) {
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{format: JSON, want: "[]\n"},
+		{format: CSV, want: "day,part,status,answer,duration_ns,error\n"},
+	}
+
+	for _, tt := range tests {
+		var out strings.Builder
+		if err := NewWriter(tt.format, &out, &out).Close(); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != tt.want {
+			t.Errorf("empty %s output = %q, want %q", tt.format, out.String(), tt.want)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, s := range []string{"text", "json", "csv"} {
+		if f, err := ParseFormat(s); err != nil || string(f) != s {
+			t.Errorf("ParseFormat(%q) = %q, %v", s, f, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error(`ParseFormat("xml") succeeded`)
+	}
+}
@@ -0,0 +1,233 @@
+// Package runner runs the registered solvers, optionally several days at once and with a
+// deadline per part, and reports each part's outcome as a Result, which can be written
+// as human-readable text, JSON or CSV.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"aoc2024/registry"
+	"aoc2024/utility"
+)
+
+var (
+	// ErrNoSolver is reported for days without a registered solver
+	ErrNoSolver = errors.New("no solver registered")
+	// ErrPanic is reported for parts whose solver panicked
+	ErrPanic = errors.New("solver panicked")
+)
+
+// Status summarises how a part finished
+type Status string
+
+const (
+	OK       Status = "ok"
+	Failed   Status = "error"
+	TimedOut Status = "timeout"
+)
+
+// Result is the outcome of running one part of a day
+type Result struct {
+	Day      int
+	Part     int
+	Answer   string
+	Duration time.Duration
+	Err      error
+}
+
+// Status reports whether the part succeeded, failed or ran out of time
+func (r Result) Status() Status {
+	switch {
+	case r.Err == nil:
+		return OK
+	case errors.Is(r.Err, context.DeadlineExceeded):
+		return TimedOut
+	default:
+		return Failed
+	}
+}
+
+// Parts returns the parts to run: both when part is 0, otherwise only the given one
+func Parts(part int) []int {
+	if part == 0 {
+		return []int{1, 2}
+	}
+	return []int{part}
+}
+
+// Solve runs one part of a solver on input and times it. Solvers cannot be interrupted,
+// so when ctx is done first the part is abandoned: the result carries ctx's error at
+// once, but its goroutine keeps running in the background until the solver returns. A
+// timeout therefore stops the waiting, not the work. A solver that panics fails its part
+// with ErrPanic rather than bringing down the program.
+func Solve(ctx context.Context, s registry.Solver, day, part int, input []string) Result {
+	return solve(ctx, s, day, part, input, func() {})
+}
+
+// solve is Solve, calling release once the solver has returned, which for an abandoned
+// part is after solve itself has returned
+func solve(ctx context.Context, s registry.Solver, day, part int, input []string, release func()) Result {
+	solvePart := s.Part1
+	if part == 2 {
+		solvePart = s.Part2
+	}
+
+	type outcome struct {
+		answer any
+		err    error
+	}
+	done := make(chan outcome, 1)
+	start := time.Now()
+	go func() {
+		defer release()
+		var o outcome
+		defer func() {
+			if p := recover(); p != nil {
+				o = outcome{err: fmt.Errorf("%w: %v", ErrPanic, p)}
+			}
+			done <- o
+		}()
+		o.answer, o.err = solvePart(input)
+	}()
+
+	r := Result{Day: day, Part: part}
+	select {
+	case o := <-done:
+		r.Err = o.err
+		if o.err == nil {
+			r.Answer = fmt.Sprint(o.answer)
+		}
+	case <-ctx.Done():
+		r.Err = ctx.Err()
+	}
+	r.Duration = time.Since(start)
+	return r
+}
+
+// RunDay loads the input of day from path and solves the given parts, giving each part
+// at most timeout to finish (no limit if timeout is 0). If the solver or the input is
+// missing, every part is reported with that error. Parts the puzzle does not have are left out.
+func RunDay(ctx context.Context, day int, parts []int, path string, timeout time.Duration) []Result {
+	return runDay(ctx, day, parts, path, timeout, nil)
+}
+
+// runDay is RunDay, running each part only once it can take a slot from slots, if not
+// nil, and giving the slot back when the solver returns rather than when it times out.
+// The part's deadline covers the wait for a slot, so solvers that never return cannot
+// hold up the parts behind them forever: those parts time out instead.
+func runDay(ctx context.Context, day int, parts []int, path string, timeout time.Duration,
+	slots chan struct{}) []Result {
+	s, ok := registry.Lookup(day)
+	if !ok {
+		return failAll(day, parts, ErrNoSolver)
+	}
+	input, err := utility.ReadFile(path)
+	if err != nil {
+		return failAll(day, parts, err)
+	}
+
+	results := make([]Result, 0, len(parts))
+	for _, part := range parts {
+		partCtx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout > 0 {
+			partCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		r := runPart(partCtx, s, day, part, input, slots)
+		cancel()
+		if errors.Is(r.Err, registry.ErrNoPart) {
+			continue
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// runPart waits for a slot, if slots is not nil, and solves the part, giving up with
+// ctx's error if it is done first
+func runPart(ctx context.Context, s registry.Solver, day, part int, input []string, slots chan struct{}) Result {
+	if slots == nil {
+		return solve(ctx, s, day, part, input, func() {})
+	}
+	start := time.Now()
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return Result{Day: day, Part: part, Err: ctx.Err(), Duration: time.Since(start)}
+	}
+	return solve(ctx, s, day, part, input, func() { <-slots })
+}
+
+// Task is a day to run and the file holding its input
+type Task struct {
+	Day  int
+	Path string
+}
+
+// Options control how Run schedules tasks
+type Options struct {
+	// Parts lists the parts to run for each day
+	Parts []int
+	// Workers is how many solvers may run at once; 0 means one per CPU. A part that
+	// times out keeps its worker until its solver returns.
+	Workers int
+	// Timeout is the deadline for each part; 0 means no deadline
+	Timeout time.Duration
+}
+
+// Run solves the tasks with up to opts.Workers solvers running at once and calls emit with
+// the results in task order, from the calling goroutine, as soon as each day and all the
+// days before it have finished. A solver abandoned after a timeout still counts against
+// opts.Workers until it returns, so later parts wait for it rather than run beside it.
+// Their wait counts against their own timeout, and Run itself returns without waiting
+// for abandoned solvers.
+func Run(ctx context.Context, tasks []Task, opts Options, emit func(Result)) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	done := make([]chan []Result, len(tasks))
+	for i := range done {
+		done[i] = make(chan []Result, 1)
+	}
+
+	slots := make(chan struct{}, workers)
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(tasks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				done[i] <- runDay(ctx, tasks[i].Day, opts.Parts, tasks[i].Path, opts.Timeout, slots)
+			}
+		}()
+	}
+	go func() {
+		for i := range tasks {
+			queue <- i
+		}
+		close(queue)
+	}()
+
+	for i := range tasks {
+		for _, r := range <-done[i] {
+			emit(r)
+		}
+	}
+	wg.Wait()
+}
+
+// failAll reports the same error for every part of a day
+func failAll(day int, parts []int, err error) []Result {
+	results := make([]Result, 0, len(parts))
+	for _, part := range parts {
+		results = append(results, Result{Day: day, Part: part, Err: err})
+	}
+	return results
+}
@@ -0,0 +1,236 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"aoc2024/registry"
+)
+
+var errBadInput = errors.New("bad input")
+
+// fakeSolver echoes the first input line in part 1 and has no part 2
+type fakeSolver struct{}
+
+func (fakeSolver) Part1(input []string) (any, error) {
+	if len(input) == 0 {
+		return nil, errBadInput
+	}
+	return input[0], nil
+}
+
+func (fakeSolver) Part2([]string) (any, error) {
+	return nil, registry.ErrNoPart
+}
+
+// slowSolver never finishes part 1 within a test's timeout and answers part 2 at once.
+// It keeps count of the most parts that were running at the same time.
+type slowSolver struct{}
+
+var running, mostRunning atomic.Int32
+
+func (slowSolver) enter() func() {
+	n := running.Add(1)
+	for {
+		m := mostRunning.Load()
+		if n <= m || mostRunning.CompareAndSwap(m, n) {
+			break
+		}
+	}
+	return func() { running.Add(-1) }
+}
+
+func (s slowSolver) Part1([]string) (any, error) {
+	defer s.enter()()
+	time.Sleep(300 * time.Millisecond)
+	return 0, nil
+}
+
+func (s slowSolver) Part2([]string) (any, error) {
+	defer s.enter()()
+	return "fast", nil
+}
+
+// panicSolver panics in part 1, as solvers indexing past the end of bad input do
+type panicSolver struct{}
+
+func (panicSolver) Part1(input []string) (any, error) {
+	return input[1], nil
+}
+
+func (panicSolver) Part2([]string) (any, error) {
+	return "fine", nil
+}
+
+func init() {
+	registry.Register(1, fakeSolver{})
+	registry.Register(3, slowSolver{})
+	registry.Register(4, panicSolver{})
+}
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		input      []string
+		wantAnswer string
+		wantErr    error
+	}{
+		{input: []string{"42"}, wantAnswer: "42"},
+		{input: nil, wantErr: errBadInput},
+	}
+
+	for _, tt := range tests {
+		r := Solve(context.Background(), fakeSolver{}, 1, 1, tt.input)
+		if r.Day != 1 || r.Part != 1 || r.Answer != tt.wantAnswer || !errors.Is(r.Err, tt.wantErr) {
+			t.Errorf("Solve(%q) = %+v, want answer %q and error %v", tt.input, r, tt.wantAnswer, tt.wantErr)
+		}
+	}
+}
+
+func TestSolvePanic(t *testing.T) {
+	r := Solve(context.Background(), panicSolver{}, 4, 1, nil)
+	if !errors.Is(r.Err, ErrPanic) || r.Status() != Failed {
+		t.Errorf("Solve() = %+v, want a failure with %v", r, ErrPanic)
+	}
+
+	// The rest of the run carries on
+	path := filepath.Join(t.TempDir(), "day04.txt")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var statuses []Status
+	Run(context.Background(), []Task{{Day: 4, Path: path}, {Day: 4, Path: path}}, Options{Parts: Parts(0)},
+		func(r Result) { statuses = append(statuses, r.Status()) })
+	if want := []Status{Failed, OK, Failed, OK}; !reflect.DeepEqual(statuses, want) {
+		t.Errorf("Run() statuses = %v, want %v", statuses, want)
+	}
+}
+
+func TestRunDay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "day01.txt")
+	if err := os.WriteFile(path, []byte("7\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		day     int
+		path    string
+		want    []string
+		wantErr error
+	}{
+		{name: "skips missing part", day: 1, path: path, want: []string{"7"}},
+		{name: "missing input", day: 1, path: path + ".missing", want: []string{"", ""}, wantErr: os.ErrNotExist},
+		{name: "missing solver", day: 2, path: path, want: []string{"", ""}, wantErr: ErrNoSolver},
+	}
+
+	for _, tt := range tests {
+		results := RunDay(context.Background(), tt.day, Parts(0), tt.path, 0)
+		if len(results) != len(tt.want) {
+			t.Errorf("%s: RunDay() = %+v, want %d results", tt.name, results, len(tt.want))
+			continue
+		}
+		for i, r := range results {
+			if r.Day != tt.day || r.Part != i+1 || r.Answer != tt.want[i] || !errors.Is(r.Err, tt.wantErr) {
+				t.Errorf("%s: RunDay()[%d] = %+v, want answer %q and error %v", tt.name, i, r, tt.want[i], tt.wantErr)
+			}
+		}
+	}
+}
+
+func TestRunDayTimeout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "day03.txt")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	results := RunDay(context.Background(), 3, Parts(0), path, 10*time.Millisecond)
+	want := []Status{TimedOut, OK}
+	if len(results) != len(want) {
+		t.Fatalf("RunDay() = %+v, want %d results", results, len(want))
+	}
+	for i, r := range results {
+		if r.Status() != want[i] {
+			t.Errorf("part %d status = %s, want %s", r.Part, r.Status(), want[i])
+		}
+	}
+	if results[0].Duration > time.Second {
+		t.Errorf("timed out part took %s, want about 10ms", results[0].Duration)
+	}
+}
+
+func TestRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte("x\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var tasks []Task
+	for _, day := range []int{3, 1, 2, 3} {
+		tasks = append(tasks, Task{Day: day, Path: path})
+	}
+
+	type key struct {
+		day, part int
+		status    Status
+	}
+	var got []key
+	var summary Summary
+	// Enough workers that the day 3 part 2s find one free beside the abandoned part 1s
+	opts := Options{Parts: Parts(0), Workers: 4, Timeout: 10 * time.Millisecond}
+	Run(context.Background(), tasks, opts, func(r Result) {
+		got = append(got, key{r.Day, r.Part, r.Status()})
+		summary.Add(r)
+	})
+
+	want := []key{
+		{3, 1, TimedOut}, {3, 2, OK},
+		{1, 1, OK},
+		{2, 1, Failed}, {2, 2, Failed},
+		{3, 1, TimedOut}, {3, 2, OK},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() emitted %v, want %v", got, want)
+	}
+	if summary.OK != 3 || summary.Failed != 2 || len(summary.TimedOut) != 2 {
+		t.Errorf("summary = %s, want 3 ok, 2 failed, 2 timed out", summary)
+	}
+}
+
+func TestRunKeepsWorkersOfAbandonedSolvers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tasks := []Task{{Day: 3, Path: path}, {Day: 3, Path: path}}
+
+	// Let parts abandoned by earlier tests finish first
+	for running.Load() != 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	mostRunning.Store(0)
+
+	// The first part holds the only worker long after it times out, so the parts behind
+	// it time out waiting for the worker instead of running or waiting forever
+	var statuses []Status
+	opts := Options{Parts: Parts(0), Workers: 1, Timeout: 10 * time.Millisecond}
+	start := time.Now()
+	Run(context.Background(), tasks, opts, func(r Result) {
+		statuses = append(statuses, r.Status())
+	})
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("Run() took %s, want about 40ms", elapsed)
+	}
+
+	want := []Status{TimedOut, TimedOut, TimedOut, TimedOut}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("Run() statuses = %v, want %v", statuses, want)
+	}
+	if n := mostRunning.Load(); n != 1 {
+		t.Errorf("%d solvers ran at once with one worker", n)
+	}
+}
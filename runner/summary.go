@@ -0,0 +1,49 @@
+package runner
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Summary tallies the results of a run
+type Summary struct {
+	OK, Failed int
+	// TimedOut lists the parts that ran out of time
+	TimedOut []Result
+	// Elapsed is the time spent in the solvers, summed over all parts
+	Elapsed time.Duration
+}
+
+// Add counts one result
+func (s *Summary) Add(r Result) {
+	switch r.Status() {
+	case OK:
+		s.OK++
+	case TimedOut:
+		s.TimedOut = append(s.TimedOut, r)
+	default:
+		s.Failed++
+	}
+	s.Elapsed += r.Duration
+}
+
+// Total returns how many parts were counted
+func (s Summary) Total() int {
+	return s.OK + s.Failed + len(s.TimedOut)
+}
+
+// String describes the counts on one line, naming the parts that timed out
+func (s Summary) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d parts: %d ok, %d failed, %d timed out (%s in solvers)",
+		s.Total(), s.OK, s.Failed, len(s.TimedOut), s.Elapsed.Round(time.Microsecond))
+	for i, r := range s.TimedOut {
+		sep := ", "
+		if i == 0 {
+			sep = "; timed out: "
+		}
+		fmt.Fprintf(&sb, "%sday %d part %d", sep, r.Day, r.Part)
+	}
+	return sb.String()
+}
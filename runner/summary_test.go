@@ -0,0 +1,25 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSummary(t *testing.T) {
+	var s Summary
+	for _, r := range []Result{
+		{Day: 1, Part: 1, Duration: time.Second},
+		{Day: 1, Part: 2, Err: errors.New("bad input")},
+		{Day: 14, Part: 2, Err: context.DeadlineExceeded, Duration: 2 * time.Second},
+		{Day: 17, Part: 2, Err: context.DeadlineExceeded, Duration: 2 * time.Second},
+	} {
+		s.Add(r)
+	}
+
+	want := "4 parts: 1 ok, 1 failed, 2 timed out (5s in solvers); timed out: day 14 part 2, day 17 part 2"
+	if got := s.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
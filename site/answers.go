@@ -0,0 +1,157 @@
+package site
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"aoc2024/utility"
+)
+
+// Rejection is an answer the website said was wrong
+type Rejection struct {
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	At      time.Time `json:"at"`
+}
+
+// PartAnswers holds everything known about the answers to one part of a puzzle. Expected
+// is an answer recorded from a run for regression tests, which the website never judged.
+type PartAnswers struct {
+	Correct  string      `json:"correct,omitempty"`
+	SolvedAt *time.Time  `json:"solved_at,omitempty"`
+	Rejected []Rejection `json:"rejected,omitempty"`
+	Expected string      `json:"expected,omitempty"`
+}
+
+// AnswerBook is the local record of verified and rejected answers, keyed by day and part
+type AnswerBook struct {
+	path string
+	Days map[int]map[int]*PartAnswers `json:"days"`
+}
+
+// AnswersPath returns where the answer book is kept inside the inputs directory dir
+func AnswersPath(dir string) string {
+	return filepath.Join(dir, strconv.Itoa(utility.Year), "answers.json")
+}
+
+// LoadAnswers reads the answer book at path. A missing file yields an empty book.
+func LoadAnswers(path string) (*AnswerBook, error) {
+	book := &AnswerBook{path: path, Days: make(map[int]map[int]*PartAnswers)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return book, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, book); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if book.Days == nil {
+		book.Days = make(map[int]map[int]*PartAnswers)
+	}
+	return book, nil
+}
+
+// Save writes the answer book back to the file it was loaded from
+func (b *AnswerBook) Save() error {
+	if err := os.MkdirAll(filepath.Dir(b.path), 0o750); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(b.path, append(data, '\n'), 0o600)
+}
+
+// lookup returns the record for a part, or nil if nothing is known about it
+func (b *AnswerBook) lookup(day, part int) *PartAnswers {
+	return b.Days[day][part]
+}
+
+// Correct returns the verified answer for a part, if there is one
+func (b *AnswerBook) Correct(day, part int) (string, bool) {
+	if p := b.lookup(day, part); p != nil && p.Correct != "" {
+		return p.Correct, true
+	}
+	return "", false
+}
+
+// Expected returns the answer regression tests should expect for a part: the verified
+// answer if there is one, or else the one recorded from a run
+func (b *AnswerBook) Expected(day, part int) (string, bool) {
+	if correct, ok := b.Correct(day, part); ok {
+		return correct, true
+	}
+	if p := b.lookup(day, part); p != nil && p.Expected != "" {
+		return p.Expected, true
+	}
+	return "", false
+}
+
+// KnownWrong reports whether answer can be ruled out without asking the website:
+// either it was rejected before, or it is beyond a bound set by an earlier
+// too high or too low verdict. The returned string explains why.
+func (b *AnswerBook) KnownWrong(day, part int, answer string) (string, bool) {
+	p := b.lookup(day, part)
+	if p == nil {
+		return "", false
+	}
+
+	value, numErr := strconv.ParseInt(answer, 10, 64)
+	for _, r := range p.Rejected {
+		if r.Answer == answer {
+			return fmt.Sprintf("%s was rejected on %s", answer, r.At.Format(time.DateTime)), true
+		}
+
+		bound, err := strconv.ParseInt(r.Answer, 10, 64)
+		if numErr != nil || err != nil {
+			continue
+		}
+		if r.Verdict == TooHigh && value >= bound {
+			return fmt.Sprintf("%s is too high because %s already was", answer, r.Answer), true
+		}
+		if r.Verdict == TooLow && value <= bound {
+			return fmt.Sprintf("%s is too low because %s already was", answer, r.Answer), true
+		}
+	}
+	return "", false
+}
+
+// entry returns the record for a part, creating it if nothing is known about it yet
+func (b *AnswerBook) entry(day, part int) *PartAnswers {
+	if b.Days[day] == nil {
+		b.Days[day] = make(map[int]*PartAnswers)
+	}
+	p := b.Days[day][part]
+	if p == nil {
+		p = &PartAnswers{}
+		b.Days[day][part] = p
+	}
+	return p
+}
+
+// RecordExpected stores an answer for regression tests without a verdict, so that
+// Submit still asks the website about it
+func (b *AnswerBook) RecordExpected(day, part int, answer string) {
+	b.entry(day, part).Expected = answer
+}
+
+// Record stores the website's verdict on an answer
+func (b *AnswerBook) Record(day, part int, answer string, verdict Verdict, at time.Time) {
+	p := b.entry(day, part)
+
+	if verdict == Correct {
+		solvedAt := at.UTC()
+		p.Correct = answer
+		p.SolvedAt = &solvedAt
+		return
+	}
+	p.Rejected = append(p.Rejected, Rejection{Answer: answer, Verdict: verdict, At: at.UTC()})
+}
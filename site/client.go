@@ -0,0 +1,244 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the address of the Advent of Code website
+	DefaultBaseURL = "https://adventofcode.com"
+
+	// DefaultUserAgent identifies this tool to the Advent of Code maintainers, as they ask automated tools to do
+	DefaultUserAgent = "github.com/Adz-ai/AdventOfCode2024 (aoc2024 command)"
+
+	defaultMinInterval = time.Second
+	defaultBackoff     = 2 * time.Second
+	defaultMaxRetries  = 3
+)
+
+var (
+	ErrNoSession   = errors.New("no session token: set AOC_SESSION_TOKEN")
+	ErrBadSession  = errors.New("session token rejected; it may have expired")
+	ErrNotUnlocked = errors.New("puzzle has not unlocked yet")
+)
+
+// HTTPError is returned for responses that are neither successful nor worth retrying
+type HTTPError struct {
+	StatusCode int
+	URL        string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("%s: unexpected status %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Doer sends HTTP requests. *http.Client satisfies it, and tests can substitute their own.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client talks to the Advent of Code website on behalf of a logged-in user.
+// Requests are spaced at least MinInterval apart, and failed GET requests are retried
+// with exponential backoff. A POST may have taken effect even when it appears to fail,
+// so it is only retried when the server rejects it with 429 and a Retry-After header.
+type Client struct {
+	BaseURL     string
+	Session     string
+	UserAgent   string
+	HTTP        Doer
+	MinInterval time.Duration
+	Backoff     time.Duration
+	MaxRetries  int
+	Now         func() time.Time
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+// NewClient creates a client for the real website using the given session cookie
+func NewClient(session string) *Client {
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		Session:     session,
+		UserAgent:   DefaultUserAgent,
+		HTTP:        &http.Client{Timeout: 30 * time.Second},
+		MinInterval: defaultMinInterval,
+		Backoff:     defaultBackoff,
+		MaxRetries:  defaultMaxRetries,
+		Now:         time.Now,
+	}
+}
+
+// UnlockTime returns when the given day's puzzle is released: midnight US Eastern time (UTC-5)
+func UnlockTime(year, day int) time.Time {
+	return time.Date(year, time.December, day, 5, 0, 0, 0, time.UTC)
+}
+
+// checkUnlocked returns ErrNotUnlocked if the puzzle for day cannot be requested yet
+func (c *Client) checkUnlocked(year, day int) error {
+	unlock := UnlockTime(year, day)
+	if c.now().Before(unlock) {
+		return fmt.Errorf("%w: day %d unlocks at %s", ErrNotUnlocked, day, unlock.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// now returns the current time according to the client's clock
+func (c *Client) now() time.Time {
+	if c.Now == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
+
+// httpClient returns the configured Doer, falling back to http.DefaultClient
+func (c *Client) httpClient() Doer {
+	if c.HTTP == nil {
+		return http.DefaultClient
+	}
+	return c.HTTP
+}
+
+// get performs a GET request against the website and returns the response body
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, path, "")
+}
+
+// post submits an urlencoded form to the website and returns the response body
+func (c *Client) post(ctx context.Context, path, form string) ([]byte, error) {
+	return c.do(ctx, http.MethodPost, path, form)
+}
+
+// do sends a request, retrying on rate limiting, server errors and transport failures.
+// Requests other than GET are only retried on rate limiting with a Retry-After header.
+func (c *Client) do(ctx context.Context, method, path, form string) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	url := strings.TrimRight(c.BaseURL, "/") + path
+	delay := c.Backoff
+	for attempt := 0; ; attempt++ {
+		if err := c.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		body, retryAfter, err := c.send(ctx, method, url, form)
+		if err == nil || retryAfter < 0 || attempt >= c.MaxRetries {
+			return body, err
+		}
+
+		if retryAfter < delay {
+			retryAfter = delay
+		}
+		if err := sleep(ctx, retryAfter); err != nil {
+			return nil, err
+		}
+		delay *= 2
+	}
+}
+
+// send performs a single request. A negative retry delay means the failure is permanent;
+// otherwise it is the minimum delay the server asked for before trying again. Failures
+// after which the server may have acted on a request other than GET are permanent.
+func (c *Client) send(ctx context.Context, method, url, form string) (body []byte, retry time.Duration, err error) {
+	idempotent := method == http.MethodGet
+	retryable := func(retry time.Duration) time.Duration {
+		if !idempotent {
+			return -1
+		}
+		return retry
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(form))
+	if err != nil {
+		return nil, -1, err
+	}
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, -1, ctx.Err()
+		}
+		return nil, retryable(0), err
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, retryable(0), err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return body, 0, nil
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized:
+		return nil, -1, ErrBadSession
+	case resp.StatusCode == http.StatusTooManyRequests:
+		// Rate limiting with Retry-After says the request was turned away, so it is safe to resend
+		if after, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return nil, after, &HTTPError{resp.StatusCode, url}
+		}
+		return nil, retryable(0), &HTTPError{resp.StatusCode, url}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		after, _ := parseRetryAfter(resp.Header.Get("Retry-After"))
+		return nil, retryable(after), &HTTPError{resp.StatusCode, url}
+	default:
+		return nil, -1, &HTTPError{resp.StatusCode, url}
+	}
+}
+
+// wait blocks until at least MinInterval has passed since the previous request
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.lastRequest.IsZero() {
+		if remaining := c.MinInterval - time.Since(c.lastRequest); remaining > 0 {
+			if err := sleep(ctx, remaining); err != nil {
+				return err
+			}
+		}
+	}
+	c.lastRequest = time.Now()
+	return nil
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds, reporting false if it is
+// absent or malformed
+func parseRetryAfter(header string) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(strings.TrimSpace(header))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// sleep pauses for d or until ctx is cancelled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,122 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"aoc2024/utility"
+)
+
+// Meta records where and when a cached input was downloaded
+type Meta struct {
+	Year      int       `json:"year"`
+	Day       int       `json:"day"`
+	URL       string    `json:"url"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Bytes     int       `json:"bytes"`
+}
+
+// Cache stores downloaded inputs as <Dir>/<year>/dayNN.txt, the layout read by
+// utility.ReadDayInput, with a dayNN.meta.json file next to each input
+type Cache struct {
+	Dir string
+}
+
+// inputPath returns where the input for day is stored
+func (c Cache) inputPath(day int) string {
+	return utility.InputPath(c.Dir, day)
+}
+
+// metaPath returns where the metadata for day is stored
+func (c Cache) metaPath(day int) string {
+	return strings.TrimSuffix(c.inputPath(day), ".txt") + ".meta.json"
+}
+
+// Lookup returns the metadata of a cached input. The boolean is false when the input
+// has not been cached. Inputs placed by hand without metadata count as cached.
+func (c Cache) Lookup(day int) (Meta, bool, error) {
+	if _, err := os.Stat(c.inputPath(day)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Meta{}, false, nil
+		}
+		return Meta{}, false, err
+	}
+
+	meta := Meta{Year: utility.Year, Day: day}
+	data, err := os.ReadFile(c.metaPath(day))
+	if errors.Is(err, os.ErrNotExist) {
+		return meta, true, nil
+	}
+	if err != nil {
+		return Meta{}, false, err
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return Meta{}, false, fmt.Errorf("reading %s: %w", c.metaPath(day), err)
+	}
+	return meta, true, nil
+}
+
+// Store writes an input and its metadata into the cache
+func (c Cache) Store(meta Meta, input []byte) error {
+	path := c.inputPath(meta.Day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, input, 0o600); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.metaPath(meta.Day), append(data, '\n'), 0o600)
+}
+
+// FetchInput downloads the input for day. It refuses to contact the website before
+// the puzzle has unlocked.
+func (c *Client) FetchInput(ctx context.Context, day int) ([]byte, error) {
+	if err := c.checkUnlocked(utility.Year, day); err != nil {
+		return nil, err
+	}
+	return c.get(ctx, inputPath(day))
+}
+
+// Fetch returns the cached metadata for day, downloading and caching the input first
+// if it is missing or force is set. The boolean reports whether a download happened.
+func (c Cache) Fetch(ctx context.Context, client *Client, day int, force bool) (Meta, bool, error) {
+	if !force {
+		meta, ok, err := c.Lookup(day)
+		if err != nil || ok {
+			return meta, false, err
+		}
+	}
+
+	input, err := client.FetchInput(ctx, day)
+	if err != nil {
+		return Meta{}, false, err
+	}
+
+	meta := Meta{
+		Year:      utility.Year,
+		Day:       day,
+		URL:       strings.TrimRight(client.BaseURL, "/") + inputPath(day),
+		FetchedAt: client.now().UTC(),
+		Bytes:     len(input),
+	}
+	if err := c.Store(meta, input); err != nil {
+		return Meta{}, false, err
+	}
+	return meta, true, nil
+}
+
+// inputPath returns the URL path of a day's input
+func inputPath(day int) string {
+	return fmt.Sprintf("/%d/day/%d/input", utility.Year, day)
+}
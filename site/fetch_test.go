@@ -0,0 +1,160 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"aoc2024/utility"
+)
+
+// newTestClient returns a client pointed at server with no rate limiting delays,
+// and a clock set after every 2024 puzzle has unlocked
+func newTestClient(server *httptest.Server) *Client {
+	client := NewClient("secret")
+	client.BaseURL = server.URL
+	client.HTTP = server.Client()
+	client.MinInterval = 0
+	client.Backoff = time.Millisecond
+	client.Now = func() time.Time { return time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC) }
+	return client
+}
+
+func TestFetchStoresInputWithMetadata(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/2024/day/7/input" {
+			t.Errorf("path = %q, want /2024/day/7/input", r.URL.Path)
+		}
+		if got := r.Header.Get("User-Agent"); got != DefaultUserAgent {
+			t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, %v", cookie, err)
+		}
+		_, _ = w.Write([]byte("190: 10 19\n"))
+	}))
+	defer server.Close()
+
+	cache := Cache{Dir: t.TempDir()}
+	client := newTestClient(server)
+
+	meta, downloaded, err := cache.Fetch(context.Background(), client, 7, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !downloaded || meta.Bytes != 11 || meta.FetchedAt.IsZero() {
+		t.Errorf("Fetch() = %+v, %v; want a fresh download of 11 bytes", meta, downloaded)
+	}
+
+	lines, err := utility.ReadDayInput(cache.Dir, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 1 || lines[0] != "190: 10 19" {
+		t.Errorf("cached input = %q", lines)
+	}
+
+	// A second fetch is served from the cache
+	cached, downloaded, err := cache.Fetch(context.Background(), client, 7, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if downloaded || !cached.FetchedAt.Equal(meta.FetchedAt) {
+		t.Errorf("second Fetch() = %+v, %v; want cached metadata", cached, downloaded)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("server saw %d requests, want 1", requests.Load())
+	}
+}
+
+func TestFetchRefusesLockedDay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Now = func() time.Time { return time.Date(2024, time.December, 10, 4, 59, 0, 0, time.UTC) }
+
+	_, _, err := Cache{Dir: t.TempDir()}.Fetch(context.Background(), client, 10, false)
+	if !errors.Is(err, ErrNotUnlocked) {
+		t.Errorf("Fetch() error = %v, want %v", err, ErrNotUnlocked)
+	}
+}
+
+func TestFetchRetriesServerErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("1,2\n"))
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server).FetchInput(context.Background(), 18); err != nil {
+		t.Fatal(err)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("server saw %d requests, want 3", requests.Load())
+	}
+}
+
+func TestFetchDoesNotRetryClientErrors(t *testing.T) {
+	tests := []struct {
+		status  int
+		isWant  func(error) bool
+		wantMsg string
+	}{
+		{
+			status:  http.StatusBadRequest,
+			isWant:  func(err error) bool { return errors.Is(err, ErrBadSession) },
+			wantMsg: ErrBadSession.Error(),
+		},
+		{
+			status: http.StatusNotFound,
+			isWant: func(err error) bool {
+				var httpErr *HTTPError
+				return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound
+			},
+			wantMsg: "HTTPError 404",
+		},
+	}
+
+	for _, tt := range tests {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(tt.status)
+		}))
+
+		cache := Cache{Dir: t.TempDir()}
+		_, _, err := cache.Fetch(context.Background(), newTestClient(server), 1, false)
+		server.Close()
+
+		if !tt.isWant(err) {
+			t.Errorf("status %d: error = %v, want %s", tt.status, err, tt.wantMsg)
+		}
+		if requests.Load() != 1 {
+			t.Errorf("status %d: server saw %d requests, want 1", tt.status, requests.Load())
+		}
+		if _, statErr := os.Stat(utility.InputPath(cache.Dir, 1)); !errors.Is(statErr, os.ErrNotExist) {
+			t.Errorf("status %d: input was cached after a failed download", tt.status)
+		}
+	}
+}
+
+func TestFetchWithoutSession(t *testing.T) {
+	client := NewClient("")
+	if _, err := client.FetchInput(context.Background(), 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("FetchInput() error = %v, want %v", err, ErrNoSession)
+	}
+}
@@ -0,0 +1,146 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc2024/utility"
+)
+
+// Verdict is the website's judgement of a submitted answer
+type Verdict string
+
+const (
+	Correct       Verdict = "correct"
+	Wrong         Verdict = "wrong"
+	TooHigh       Verdict = "too high"
+	TooLow        Verdict = "too low"
+	RateLimited   Verdict = "rate limited"
+	AlreadySolved Verdict = "already solved"
+	Unknown       Verdict = "unknown"
+)
+
+// IsRejection reports whether the verdict means the answer itself is wrong
+func (v Verdict) IsRejection() bool {
+	return v == Wrong || v == TooHigh || v == TooLow
+}
+
+var (
+	ErrInvalidPart = errors.New("part must be 1 or 2")
+	ErrKnownWrong  = errors.New("answer is already known to be wrong")
+	ErrSolved      = errors.New("part is already solved with a different answer")
+
+	articleRegex = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRegex     = regexp.MustCompile(`<[^>]+>`)
+	spaceRegex   = regexp.MustCompile(`\s+`)
+	leftToWait   = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	waitMinutes  = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+)
+
+// Result is the parsed response to an answer submission
+type Result struct {
+	Verdict Verdict
+	// Wait is how long the website asks to wait before the next submission, if it said so
+	Wait time.Duration
+	// Message is the text of the response with markup removed
+	Message string
+}
+
+// SubmitAnswer posts an answer for the given day and part and parses the response
+func (c *Client) SubmitAnswer(ctx context.Context, day, part int, answer string) (Result, error) {
+	if part != 1 && part != 2 {
+		return Result{}, ErrInvalidPart
+	}
+	if err := c.checkUnlocked(utility.Year, day); err != nil {
+		return Result{}, err
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	body, err := c.post(ctx, fmt.Sprintf("/%d/day/%d/answer", utility.Year, day), form.Encode())
+	if err != nil {
+		return Result{}, err
+	}
+	return ParseResult(string(body)), nil
+}
+
+// ParseResult interprets the HTML page returned after submitting an answer
+func ParseResult(page string) Result {
+	message := page
+	if match := articleRegex.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+	message = strings.TrimSpace(spaceRegex.ReplaceAllString(tagRegex.ReplaceAllString(message, ""), " "))
+
+	result := Result{Verdict: Unknown, Message: message, Wait: parseWait(message)}
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		result.Verdict = Correct
+	case strings.Contains(message, "You gave an answer too recently"):
+		result.Verdict = RateLimited
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		result.Verdict = AlreadySolved
+	case strings.Contains(message, "That's not the right answer"):
+		switch {
+		case strings.Contains(message, "your answer is too high"):
+			result.Verdict = TooHigh
+		case strings.Contains(message, "your answer is too low"):
+			result.Verdict = TooLow
+		default:
+			result.Verdict = Wrong
+		}
+	}
+	return result
+}
+
+// parseWait extracts the delay the website asks for before the next submission
+func parseWait(message string) time.Duration {
+	if match := leftToWait.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1]) // empty when under a minute
+		seconds, _ := strconv.Atoi(match[2])
+		return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	}
+	if match := waitMinutes.FindStringSubmatch(message); match != nil {
+		if match[1] == "one" {
+			return time.Minute
+		}
+		minutes, _ := strconv.Atoi(match[1])
+		return time.Duration(minutes) * time.Minute
+	}
+	return 0
+}
+
+// Submit posts an answer unless the answer book already settles it.
+// An answer equal to the recorded correct one is reported as Correct without contacting
+// the website. Answers that were rejected before, or that lie outside a previously
+// reported too high/too low bound, fail with ErrKnownWrong. Definite verdicts from the
+// website are recorded in the book and saved.
+func Submit(ctx context.Context, client *Client, book *AnswerBook, day, part int, answer string) (Result, error) {
+	if correct, ok := book.Correct(day, part); ok {
+		if correct == answer {
+			return Result{Verdict: Correct, Message: "answer matches the recorded correct answer"}, nil
+		}
+		return Result{}, fmt.Errorf("%w: recorded answer is %s", ErrSolved, correct)
+	}
+	if reason, wrong := book.KnownWrong(day, part, answer); wrong {
+		return Result{}, fmt.Errorf("%w: %s", ErrKnownWrong, reason)
+	}
+
+	result, err := client.SubmitAnswer(ctx, day, part, answer)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if result.Verdict == Correct || result.Verdict.IsRejection() {
+		book.Record(day, part, answer, result.Verdict, client.now())
+		if err := book.Save(); err != nil {
+			return result, fmt.Errorf("recording answer: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,229 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	correctPage = `<main><article><p>That's the right answer!  You are <em>one gold star</em> closer.</p></article></main>`
+	tooHighPage = `<article><p>That's not the right answer; your answer is too high.  ` +
+		`Please wait one minute before trying again.</p></article>`
+	tooRecentPage = `<article><p>You gave an answer too recently; you have to wait after submitting an answer ` +
+		`before trying again.  You have 1m 37s left to wait.</p></article>`
+)
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		name        string
+		page        string
+		wantVerdict Verdict
+		wantWait    time.Duration
+	}{
+		{"correct", correctPage, Correct, 0},
+		{"too high", tooHighPage, TooHigh, time.Minute},
+		{
+			"too low",
+			`<article><p>That's not the right answer; your answer is too low.</p></article>`,
+			TooLow, 0,
+		},
+		{
+			"wrong",
+			`<article><p>That's not the right answer.  Please wait 5 minutes before trying again.</p></article>`,
+			Wrong, 5 * time.Minute,
+		},
+		{"rate limited", tooRecentPage, RateLimited, time.Minute + 37*time.Second},
+		{
+			"already solved",
+			`<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`,
+			AlreadySolved, 0,
+		},
+		{"unknown", `<html>maintenance</html>`, Unknown, 0},
+	}
+
+	for _, tt := range tests {
+		got := ParseResult(tt.page)
+		if got.Verdict != tt.wantVerdict || got.Wait != tt.wantWait {
+			t.Errorf("%s: ParseResult() = %v, %v; want %v, %v", tt.name, got.Verdict, got.Wait, tt.wantVerdict, tt.wantWait)
+		}
+	}
+}
+
+// newAnswerServer returns a fake submission endpoint that replies with page and counts requests
+func newAnswerServer(t *testing.T, page string, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/5/answer" {
+			t.Errorf("request = %s %s, want POST /2024/day/5/answer", r.Method, r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if r.PostForm.Get("level") != "2" {
+			t.Errorf("level = %q, want 2", r.PostForm.Get("level"))
+		}
+		_, _ = w.Write([]byte(page))
+	}))
+}
+
+func TestSubmitRecordsCorrectAnswer(t *testing.T) {
+	var requests atomic.Int32
+	server := newAnswerServer(t, correctPage, &requests)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "answers.json")
+	book, err := LoadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Submit(context.Background(), newTestClient(server), book, 5, 2, "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Verdict != Correct {
+		t.Errorf("Verdict = %v, want %v", result.Verdict, Correct)
+	}
+
+	reloaded, err := LoadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := reloaded.Correct(5, 2); !ok || got != "123" {
+		t.Errorf("recorded answer = %q, %v; want 123", got, ok)
+	}
+
+	// Resubmitting the verified answer is answered locally
+	if _, err := Submit(context.Background(), newTestClient(server), reloaded, 5, 2, "123"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Submit(context.Background(), newTestClient(server), reloaded, 5, 2, "124"); !errors.Is(err, ErrSolved) {
+		t.Errorf("Submit() error = %v, want %v", err, ErrSolved)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("server saw %d requests, want 1", requests.Load())
+	}
+}
+
+func TestSubmitNeverRepeatsRejectedAnswers(t *testing.T) {
+	var requests atomic.Int32
+	server := newAnswerServer(t, tooHighPage, &requests)
+	defer server.Close()
+
+	book, err := LoadAnswers(filepath.Join(t.TempDir(), "answers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newTestClient(server)
+
+	result, err := Submit(context.Background(), client, book, 5, 2, "500")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Verdict != TooHigh {
+		t.Fatalf("Verdict = %v, want %v", result.Verdict, TooHigh)
+	}
+
+	for _, answer := range []string{"500", "501"} {
+		if _, err := Submit(context.Background(), client, book, 5, 2, answer); !errors.Is(err, ErrKnownWrong) {
+			t.Errorf("Submit(%s) error = %v, want %v", answer, err, ErrKnownWrong)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("server saw %d requests, want 1", requests.Load())
+	}
+}
+
+func TestSubmitDoesNotRecordRateLimit(t *testing.T) {
+	var requests atomic.Int32
+	server := newAnswerServer(t, tooRecentPage, &requests)
+	defer server.Close()
+
+	book, err := LoadAnswers(filepath.Join(t.TempDir(), "answers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Submit(context.Background(), newTestClient(server), book, 5, 2, "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Verdict != RateLimited || result.Wait != time.Minute+37*time.Second {
+		t.Errorf("Submit() = %v, %v; want rate limited for 1m37s", result.Verdict, result.Wait)
+	}
+	if _, wrong := book.KnownWrong(5, 2, "42"); wrong {
+		t.Error("rate limited answer was recorded as wrong")
+	}
+}
+
+func TestSubmitIgnoresExpectedAnswers(t *testing.T) {
+	var requests atomic.Int32
+	server := newAnswerServer(t, tooHighPage, &requests)
+	defer server.Close()
+
+	book, err := LoadAnswers(filepath.Join(t.TempDir(), "answers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	book.RecordExpected(5, 2, "500")
+	if got, ok := book.Expected(5, 2); !ok || got != "500" {
+		t.Errorf("Expected() = %q, %v; want 500", got, ok)
+	}
+
+	// An answer recorded from a run is still sent to the website, and its verdict kept
+	result, err := Submit(context.Background(), newTestClient(server), book, 5, 2, "500")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Verdict != TooHigh || requests.Load() != 1 {
+		t.Errorf("Submit() = %v after %d requests, want %v after 1", result.Verdict, requests.Load(), TooHigh)
+	}
+	if _, ok := book.Correct(5, 2); ok {
+		t.Error("expected answer was taken as correct")
+	}
+}
+
+func TestSubmitRetriesOnlyExplicitRateLimits(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		retryAfter   string
+		wantRequests int32
+		wantErr      bool
+	}{
+		{name: "server error", status: http.StatusServiceUnavailable, wantRequests: 1, wantErr: true},
+		{name: "rate limited without Retry-After", status: http.StatusTooManyRequests, wantRequests: 1, wantErr: true},
+		{name: "rate limited with Retry-After", status: http.StatusTooManyRequests, retryAfter: "0", wantRequests: 2},
+	}
+
+	for _, tt := range tests {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if requests.Add(1) == 1 {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+				return
+			}
+			_, _ = w.Write([]byte(correctPage))
+		}))
+
+		result, err := newTestClient(server).SubmitAnswer(context.Background(), 5, 2, "123")
+		server.Close()
+
+		if (err != nil) != tt.wantErr || (err == nil && result.Verdict != Correct) {
+			t.Errorf("%s: SubmitAnswer() = %v, %v, want error %v", tt.name, result.Verdict, err, tt.wantErr)
+		}
+		if requests.Load() != tt.wantRequests {
+			t.Errorf("%s: server saw %d requests, want %d", tt.name, requests.Load(), tt.wantRequests)
+		}
+	}
+}
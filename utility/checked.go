@@ -0,0 +1,57 @@
+package utility
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrOverflow is returned when the result of an operation does not fit its integer type
+var ErrOverflow = errors.New("integer overflow")
+
+// Signed is any signed integer type
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// AddChecked returns a + b, or ErrOverflow if the sum does not fit in T
+func AddChecked[T Signed](a, b T) (T, error) {
+	c := a + b
+	if (c > a) != (b > 0) {
+		return 0, fmt.Errorf("%w: %d + %d", ErrOverflow, a, b)
+	}
+	return c, nil
+}
+
+// SubChecked returns a - b, or ErrOverflow if the difference does not fit in T
+func SubChecked[T Signed](a, b T) (T, error) {
+	c := a - b
+	if (c < a) != (b > 0) {
+		return 0, fmt.Errorf("%w: %d - %d", ErrOverflow, a, b)
+	}
+	return c, nil
+}
+
+// MulChecked returns a * b, or ErrOverflow if the product does not fit in T
+func MulChecked[T Signed](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	// Multiplying the most negative value by -1 wraps back to itself, which the
+	// division check cannot see
+	if (a == -1 && c == b) || (b == -1 && c == a) || c/b != a {
+		return 0, fmt.Errorf("%w: %d * %d", ErrOverflow, a, b)
+	}
+	return c, nil
+}
+
+// BigInt64 returns x as an int64, or ErrOverflow if it is out of range. It brings
+// results back from math/big, for calculations redone exactly after a checked
+// operation overflowed.
+func BigInt64(x *big.Int) (int64, error) {
+	if !x.IsInt64() {
+		return 0, fmt.Errorf("%w: %v", ErrOverflow, x)
+	}
+	return x.Int64(), nil
+}
@@ -0,0 +1,56 @@
+package utility
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      func(int64, int64) (int64, error)
+		a, b    int64
+		want    int64
+		wantErr bool
+	}{
+		{name: "add", op: AddChecked[int64], a: 2, b: -5, want: -3},
+		{name: "add", op: AddChecked[int64], a: math.MaxInt64, b: 0, want: math.MaxInt64},
+		{name: "add", op: AddChecked[int64], a: math.MaxInt64, b: 1, wantErr: true},
+		{name: "add", op: AddChecked[int64], a: math.MinInt64, b: -1, wantErr: true},
+		{name: "sub", op: SubChecked[int64], a: -3, b: 4, want: -7},
+		{name: "sub", op: SubChecked[int64], a: math.MinInt64, b: 1, wantErr: true},
+		{name: "sub", op: SubChecked[int64], a: 0, b: math.MinInt64, wantErr: true},
+		{name: "mul", op: MulChecked[int64], a: -4, b: 6, want: -24},
+		{name: "mul", op: MulChecked[int64], a: 0, b: math.MinInt64, want: 0},
+		{name: "mul", op: MulChecked[int64], a: -1, b: math.MaxInt64, want: -math.MaxInt64},
+		{name: "mul", op: MulChecked[int64], a: 1 << 32, b: 1 << 31, wantErr: true},
+		{name: "mul", op: MulChecked[int64], a: -1, b: math.MinInt64, wantErr: true},
+		{name: "mul", op: MulChecked[int64], a: math.MinInt64, b: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.op(tt.a, tt.b)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("%s(%d, %d) = %d, %v, want %d, error %v", tt.name, tt.a, tt.b, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrOverflow) {
+			t.Errorf("%s(%d, %d) error = %v, want %v", tt.name, tt.a, tt.b, err, ErrOverflow)
+		}
+	}
+
+	if _, err := MulChecked[int8](16, 8); !errors.Is(err, ErrOverflow) {
+		t.Errorf("MulChecked[int8](16, 8) error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestBigInt64(t *testing.T) {
+	x := new(big.Int).Lsh(big.NewInt(1), 62)
+	if got, err := BigInt64(x); err != nil || got != 1<<62 {
+		t.Errorf("BigInt64(2^62) = %d, %v", got, err)
+	}
+	if _, err := BigInt64(x.Lsh(x, 1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("BigInt64(2^63) error = %v, want %v", err, ErrOverflow)
+	}
+}
@@ -0,0 +1,136 @@
+package container
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	q := NewPriorityQueue(func(a, b int) bool { return a > b })
+	var want []int
+	for range 200 {
+		v := rng.IntN(50)
+		q.Push(v)
+		want = append(want, v)
+	}
+	slices.Sort(want)
+	slices.Reverse(want)
+
+	if top, ok := q.Peek(); !ok || top != want[0] || q.Len() != len(want) {
+		t.Errorf("Peek() = %d, %v with %d elements, want %d with %d", top, ok, q.Len(), want[0], len(want))
+	}
+	for i, w := range want {
+		if got, ok := q.Pop(); !ok || got != w {
+			t.Fatalf("Pop() %d = %d, %v, want %d", i, got, ok, w)
+		}
+	}
+	if _, ok := q.Pop(); ok {
+		t.Error("Pop() of an empty queue succeeded")
+	}
+}
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if _, ok := d.PopFront(); ok {
+		t.Error("PopFront() of an empty deque succeeded")
+	}
+
+	// Wrap around the ring and grow it several times
+	var want []int
+	for i := range 50 {
+		if i%3 == 0 {
+			d.PushFront(i)
+			want = append([]int{i}, want...)
+		} else {
+			d.PushBack(i)
+			want = append(want, i)
+		}
+		if i%7 == 6 {
+			got, _ := d.PopFront()
+			if got != want[0] {
+				t.Fatalf("PopFront() = %d, want %d", got, want[0])
+			}
+			want = want[1:]
+		}
+	}
+
+	if d.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", d.Len(), len(want))
+	}
+	for i, w := range want {
+		if got := d.At(i); got != w {
+			t.Errorf("At(%d) = %d, want %d", i, got, w)
+		}
+	}
+	for len(want) > 0 {
+		got, ok := d.PopBack()
+		if !ok || got != want[len(want)-1] {
+			t.Fatalf("PopBack() = %d, %v, want %d", got, ok, want[len(want)-1])
+		}
+		want = want[:len(want)-1]
+	}
+	if _, ok := d.PopBack(); ok || d.Len() != 0 {
+		t.Error("PopBack() of an empty deque succeeded")
+	}
+}
+
+func TestSet(t *testing.T) {
+	a := NewSet(1, 2, 3, 3)
+	b := NewSet(3, 4)
+	if a.Len() != 3 || !a.Contains(2) || a.Contains(4) {
+		t.Errorf("NewSet(1, 2, 3, 3) = %v", Sorted(a))
+	}
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4}},
+		{"Intersection", a.Intersection(b), []int{3}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+	}
+	for _, tt := range tests {
+		if got := Sorted(tt.got); !slices.Equal(got, tt.want) {
+			t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if got := Sorted(a); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("set operations changed their receiver to %v", got)
+	}
+
+	a.Remove(1)
+	a.AddAll(slices.Values([]int{5, 6}))
+	if got := a.SortedFunc(func(x, y int) int { return y - x }); !slices.Equal(got, []int{6, 5, 3, 2}) {
+		t.Errorf("SortedFunc() = %v, want [6 5 3 2]", got)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	c := NewCounter("b", "a", "c", "a", "b", "a")
+	c.AddN("d", 2)
+	if c.Count("a") != 3 || c.Count("z") != 0 || c.Len() != 4 || c.Total() != 8 {
+		t.Errorf("counts a=%d z=%d, len %d, total %d, want 3, 0, 4, 8", c.Count("a"), c.Count("z"), c.Len(), c.Total())
+	}
+
+	want := []Entry[string]{{"a", 3}, {"b", 2}, {"d", 2}, {"c", 1}}
+	if got := c.MostCommon(0); !slices.Equal(got, want) {
+		t.Errorf("MostCommon(0) = %v, want %v", got, want)
+	}
+	if got := c.MostCommon(2); !slices.Equal(got, want[:2]) {
+		t.Errorf("MostCommon(2) = %v, want %v", got, want[:2])
+	}
+
+	var order []string
+	for x := range c.All() {
+		order = append(order, x)
+	}
+	if !slices.Equal(order, []string{"b", "a", "c", "d"}) {
+		t.Errorf("All() order = %v, want [b a c d]", order)
+	}
+	if got := Sorted(c.Keys()); !slices.Equal(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+}
@@ -0,0 +1,90 @@
+package container
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Counter counts occurrences of values, remembering the order they were first seen in
+type Counter[T comparable] struct {
+	counts map[T]int
+	order  []T
+}
+
+// Entry is a value and its count
+type Entry[T comparable] struct {
+	Value T
+	Count int
+}
+
+// NewCounter returns a counter holding one occurrence of each of items
+func NewCounter[T comparable](items ...T) *Counter[T] {
+	c := &Counter[T]{counts: make(map[T]int)}
+	for _, x := range items {
+		c.Add(x)
+	}
+	return c
+}
+
+// Add counts one more occurrence of x
+func (c *Counter[T]) Add(x T) {
+	c.AddN(x, 1)
+}
+
+// AddN counts n more occurrences of x
+func (c *Counter[T]) AddN(x T, n int) {
+	if _, ok := c.counts[x]; !ok {
+		c.order = append(c.order, x)
+	}
+	c.counts[x] += n
+}
+
+// Count returns how many times x has been counted
+func (c *Counter[T]) Count(x T) int {
+	return c.counts[x]
+}
+
+// Len returns the number of distinct values counted
+func (c *Counter[T]) Len() int {
+	return len(c.order)
+}
+
+// Total returns the sum of all counts
+func (c *Counter[T]) Total() int {
+	total := 0
+	for _, n := range c.counts {
+		total += n
+	}
+	return total
+}
+
+// All iterates over the values and their counts in the order the values were first seen
+func (c *Counter[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for _, x := range c.order {
+			if !yield(x, c.counts[x]) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon returns the n values with the highest counts, highest first, or all of them
+// if n is not positive. Values with equal counts are in the order they were first seen.
+func (c *Counter[T]) MostCommon(n int) []Entry[T] {
+	entries := make([]Entry[T], 0, len(c.order))
+	for x, count := range c.All() {
+		entries = append(entries, Entry[T]{x, count})
+	}
+	slices.SortStableFunc(entries, func(a, b Entry[T]) int { return cmp.Compare(b.Count, a.Count) })
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// Keys returns the set of values counted
+func (c *Counter[T]) Keys() Set[T] {
+	return NewSet(c.order...)
+}
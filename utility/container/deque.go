@@ -0,0 +1,78 @@
+package container
+
+// minDequeCapacity is the size of a deque's first buffer
+const minDequeCapacity = 8
+
+// Deque is a double-ended queue stored in a ring buffer that grows as needed. The zero
+// value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf   []T
+	head  int // index of the front element
+	count int
+}
+
+// Len returns the number of elements in the deque
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// PushBack adds x at the back
+func (d *Deque[T]) PushBack(x T) {
+	d.grow()
+	d.buf[(d.head+d.count)%len(d.buf)] = x
+	d.count++
+}
+
+// PushFront adds x at the front
+func (d *Deque[T]) PushFront(x T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = x
+	d.count++
+}
+
+// PopFront removes and returns the front element, or false if the deque is empty
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.count == 0 {
+		return zero, false
+	}
+	x := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.count--
+	return x, true
+}
+
+// PopBack removes and returns the back element, or false if the deque is empty
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.count == 0 {
+		return zero, false
+	}
+	i := (d.head + d.count - 1) % len(d.buf)
+	x := d.buf[i]
+	d.buf[i] = zero
+	d.count--
+	return x, true
+}
+
+// At returns the element i places from the front, which must exist
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.count {
+		panic("container: deque index out of range")
+	}
+	return d.buf[(d.head+i)%len(d.buf)]
+}
+
+// grow doubles the buffer when it is full, moving the elements to its start
+func (d *Deque[T]) grow() {
+	if d.count < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(minDequeCapacity, 2*len(d.buf)))
+	for i := range d.count {
+		buf[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+	d.buf, d.head = buf, 0
+}
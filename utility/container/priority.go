@@ -0,0 +1,76 @@
+// Package container provides generic data structures missing from the standard
+// library: a binary heap priority queue and a ring-buffer deque.
+package container
+
+// PriorityQueue is a binary heap that pops the element ordered first by its comparator
+type PriorityQueue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue returns an empty queue that pops a before b when less(a, b)
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// Len returns the number of elements in the queue
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.items)
+}
+
+// Push adds x to the queue
+func (q *PriorityQueue[T]) Push(x T) {
+	q.items = append(q.items, x)
+	q.up(len(q.items) - 1)
+}
+
+// Peek returns the first element without removing it, or false if the queue is empty
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.items[0], true
+}
+
+// Pop removes and returns the first element, or false if the queue is empty
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	var zero T
+	if len(q.items) == 0 {
+		return zero, false
+	}
+	top := q.items[0]
+	last := len(q.items) - 1
+	q.items[0] = q.items[last]
+	q.items[last] = zero // let the garbage collector have it
+	q.items = q.items[:last]
+	q.down(0)
+	return top, true
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.items[i], q.items[parent]) {
+			return
+		}
+		q.items[i], q.items[parent] = q.items[parent], q.items[i]
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) {
+	for {
+		first := i
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < len(q.items) && q.less(q.items[child], q.items[first]) {
+				first = child
+			}
+		}
+		if first == i {
+			return
+		}
+		q.items[i], q.items[first] = q.items[first], q.items[i]
+		i = first
+	}
+}
@@ -0,0 +1,98 @@
+package container
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Set is an unordered collection of distinct values. It is a map underneath, so it
+// can be ranged over and measured with len directly.
+type Set[T comparable] map[T]struct{}
+
+// NewSet returns a set holding items
+func NewSet[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	s.Add(items...)
+	return s
+}
+
+// Add puts items in the set
+func (s Set[T]) Add(items ...T) {
+	for _, x := range items {
+		s[x] = struct{}{}
+	}
+}
+
+// AddAll puts every value of seq in the set
+func (s Set[T]) AddAll(seq iter.Seq[T]) {
+	for x := range seq {
+		s[x] = struct{}{}
+	}
+}
+
+// Remove takes x out of the set
+func (s Set[T]) Remove(x T) {
+	delete(s, x)
+}
+
+// Contains reports whether x is in the set
+func (s Set[T]) Contains(x T) bool {
+	_, ok := s[x]
+	return ok
+}
+
+// Len returns the number of values in the set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// All iterates over the values in no particular order
+func (s Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
+
+// Union returns a new set of the values in s or o
+func (s Set[T]) Union(o Set[T]) Set[T] {
+	u := make(Set[T], max(len(s), len(o)))
+	u.AddAll(s.All())
+	u.AddAll(o.All())
+	return u
+}
+
+// Intersection returns a new set of the values in both s and o
+func (s Set[T]) Intersection(o Set[T]) Set[T] {
+	small, large := s, o
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	in := make(Set[T])
+	for x := range small {
+		if large.Contains(x) {
+			in[x] = struct{}{}
+		}
+	}
+	return in
+}
+
+// Difference returns a new set of the values in s but not in o
+func (s Set[T]) Difference(o Set[T]) Set[T] {
+	d := make(Set[T])
+	for x := range s {
+		if !o.Contains(x) {
+			d[x] = struct{}{}
+		}
+	}
+	return d
+}
+
+// SortedFunc returns the values ordered by cmp
+func (s Set[T]) SortedFunc(cmp func(a, b T) int) []T {
+	return slices.SortedFunc(s.All(), cmp)
+}
+
+// Sorted returns the values of an ordered set in ascending order
+func Sorted[T cmp.Ordered](s Set[T]) []T {
+	return slices.Sorted(s.All())
+}
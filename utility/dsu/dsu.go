@@ -0,0 +1,127 @@
+// Package dsu provides a disjoint-set forest (union-find) over any comparable type,
+// using union by rank and path compression so that a run of operations takes close to
+// linear time.
+package dsu
+
+// Forest partitions its elements into disjoint sets
+type Forest[T comparable] struct {
+	index  map[T]int
+	items  []T
+	parent []int
+	rank   []int
+	size   []int
+	count  int
+}
+
+// New returns an empty forest
+func New[T comparable]() *Forest[T] {
+	return &Forest[T]{index: make(map[T]int)}
+}
+
+// Add puts x in a set of its own and reports whether x was new; existing elements are
+// left where they are
+func (f *Forest[T]) Add(x T) bool {
+	if _, ok := f.index[x]; ok {
+		return false
+	}
+	i := len(f.items)
+	f.index[x] = i
+	f.items = append(f.items, x)
+	f.parent = append(f.parent, i)
+	f.rank = append(f.rank, 0)
+	f.size = append(f.size, 1)
+	f.count++
+	return true
+}
+
+// Contains reports whether x has been added
+func (f *Forest[T]) Contains(x T) bool {
+	_, ok := f.index[x]
+	return ok
+}
+
+// root returns the index of the root of the set holding index i, halving the path
+// on the way up
+func (f *Forest[T]) root(i int) int {
+	for f.parent[i] != i {
+		f.parent[i] = f.parent[f.parent[i]]
+		i = f.parent[i]
+	}
+	return i
+}
+
+// Find returns the representative of the set holding x, or false if x is not in the forest
+func (f *Forest[T]) Find(x T) (T, bool) {
+	i, ok := f.index[x]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return f.items[f.root(i)], true
+}
+
+// Union merges the sets holding x and y, adding either if it is new, and reports
+// whether they were in different sets
+func (f *Forest[T]) Union(x, y T) bool {
+	f.Add(x)
+	f.Add(y)
+	rx, ry := f.root(f.index[x]), f.root(f.index[y])
+	if rx == ry {
+		return false
+	}
+	if f.rank[rx] < f.rank[ry] {
+		rx, ry = ry, rx
+	}
+	f.parent[ry] = rx
+	f.size[rx] += f.size[ry]
+	if f.rank[rx] == f.rank[ry] {
+		f.rank[rx]++
+	}
+	f.count--
+	return true
+}
+
+// Connected reports whether x and y are in the same set; elements not in the forest
+// are connected to nothing
+func (f *Forest[T]) Connected(x, y T) bool {
+	i, okX := f.index[x]
+	j, okY := f.index[y]
+	return okX && okY && f.root(i) == f.root(j)
+}
+
+// Size returns the number of elements in the set holding x, or 0 if x is not in the forest
+func (f *Forest[T]) Size(x T) int {
+	i, ok := f.index[x]
+	if !ok {
+		return 0
+	}
+	return f.size[f.root(i)]
+}
+
+// Len returns the number of elements in the forest
+func (f *Forest[T]) Len() int {
+	return len(f.items)
+}
+
+// Count returns the number of disjoint sets
+func (f *Forest[T]) Count() int {
+	return f.count
+}
+
+// Groups returns the elements of each set. Sets are ordered by their first added
+// element, and the elements of a set by when they were added.
+func (f *Forest[T]) Groups() [][]T {
+	groups := make([][]T, 0, f.count)
+	group := make(map[int]int, f.count) // root index -> position in groups
+	for i, x := range f.items {
+		r := f.root(i)
+		g, ok := group[r]
+		if !ok {
+			g = len(groups)
+			group[r] = g
+			groups = append(groups, make([]T, 0, f.size[r]))
+		}
+		groups[g] = append(groups[g], x)
+	}
+	return groups
+}
@@ -0,0 +1,66 @@
+package dsu
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestForest(t *testing.T) {
+	f := New[string]()
+	for _, x := range []string{"a", "b", "c", "d", "e"} {
+		if !f.Add(x) {
+			t.Errorf("Add(%q) = false for a new element", x)
+		}
+	}
+	if f.Add("a") {
+		t.Error("Add() = true for an existing element")
+	}
+
+	tests := []struct {
+		x, y string
+		want bool
+	}{
+		{x: "a", y: "b", want: true},
+		{x: "c", y: "d", want: true},
+		{x: "b", y: "a", want: false},
+		{x: "d", y: "a", want: true},
+		{x: "c", y: "b", want: false},
+		{x: "f", y: "g", want: true},
+	}
+	for _, tt := range tests {
+		if got := f.Union(tt.x, tt.y); got != tt.want {
+			t.Errorf("Union(%q, %q) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	if f.Len() != 7 || f.Count() != 3 {
+		t.Errorf("Len(), Count() = %d, %d, want 7, 3", f.Len(), f.Count())
+	}
+	if f.Size("c") != 4 || f.Size("e") != 1 || f.Size("z") != 0 {
+		t.Errorf("Size() = %d, %d, %d, want 4, 1, 0", f.Size("c"), f.Size("e"), f.Size("z"))
+	}
+	if !f.Connected("a", "d") || f.Connected("a", "e") || f.Connected("z", "z") {
+		t.Error("Connected() disagrees with the unions made")
+	}
+	ra, _ := f.Find("a")
+	rd, _ := f.Find("d")
+	if _, ok := f.Find("z"); ra != rd || ok {
+		t.Errorf("Find() = %q for a and %q for d, found z = %v", ra, rd, ok)
+	}
+
+	want := [][]string{{"a", "b", "c", "d"}, {"e"}, {"f", "g"}}
+	if got := f.Groups(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Groups() = %v, want %v", got, want)
+	}
+}
+
+func TestLongChain(t *testing.T) {
+	f := New[int]()
+	const n = 100000
+	for i := 1; i < n; i++ {
+		f.Union(i-1, i)
+	}
+	if f.Count() != 1 || f.Size(0) != n || !f.Connected(0, n-1) {
+		t.Errorf("chain of %d: Count() = %d, Size() = %d", n, f.Count(), f.Size(0))
+	}
+}
@@ -0,0 +1,143 @@
+// Package geom provides two-dimensional integer points and compass directions.
+// Y grows downwards, matching rows of puzzle input, so North is {0, -1}.
+package geom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"aoc2024/utility"
+)
+
+var (
+	ErrInvalidPoint     = errors.New("invalid point")
+	ErrInvalidDirection = errors.New("invalid direction")
+)
+
+// Point is a position or offset: X is the column and Y the row
+type Point struct {
+	X, Y int
+}
+
+// Add returns p moved by q
+func (p Point) Add(q Point) Point {
+	return Point{p.X + q.X, p.Y + q.Y}
+}
+
+// Sub returns the offset from q to p
+func (p Point) Sub(q Point) Point {
+	return Point{p.X - q.X, p.Y - q.Y}
+}
+
+// Scale returns p multiplied by k
+func (p Point) Scale(k int) Point {
+	return Point{p.X * k, p.Y * k}
+}
+
+// Neg returns p pointing the other way
+func (p Point) Neg() Point {
+	return Point{-p.X, -p.Y}
+}
+
+// Manhattan returns the taxicab distance between p and q
+func (p Point) Manhattan(q Point) int {
+	return utility.Abs(p.X-q.X) + utility.Abs(p.Y-q.Y)
+}
+
+// Chebyshev returns the king-move distance between p and q
+func (p Point) Chebyshev(q Point) int {
+	return max(utility.Abs(p.X-q.X), utility.Abs(p.Y-q.Y))
+}
+
+// Wrap returns p moved into the width x height rectangle at the origin, as if the
+// rectangle's edges were joined like a torus
+func (p Point) Wrap(width, height int) Point {
+	return Point{mod(p.X, width), mod(p.Y, height)}
+}
+
+// mod returns a modulo m in the range [0, m)
+func mod(a, m int) int {
+	return (a%m + m) % m
+}
+
+// String formats p as "x,y", the form ParsePoint reads
+func (p Point) String() string {
+	return fmt.Sprintf("%d,%d", p.X, p.Y)
+}
+
+// ParsePoint reads a point written as "x,y", allowing spaces around the numbers
+func ParsePoint(s string) (Point, error) {
+	xs, ys, found := strings.Cut(s, ",")
+	if !found {
+		return Point{}, fmt.Errorf("%w: %q", ErrInvalidPoint, s)
+	}
+	x, errX := strconv.Atoi(strings.TrimSpace(xs))
+	y, errY := strconv.Atoi(strings.TrimSpace(ys))
+	if errX != nil || errY != nil {
+		return Point{}, fmt.Errorf("%w: %q", ErrInvalidPoint, s)
+	}
+	return Point{x, y}, nil
+}
+
+// Direction is one of the four compass directions, in clockwise order
+type Direction int
+
+const (
+	North Direction = iota
+	East
+	South
+	West
+)
+
+// Directions lists the compass directions clockwise from North
+var Directions = [4]Direction{North, East, South, West}
+
+var (
+	// Orthogonal holds the offsets of the four edge neighbours, clockwise from North
+	Orthogonal = [4]Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	// Adjacent holds the offsets of all eight neighbours, clockwise from North
+	Adjacent = [8]Point{{0, -1}, {1, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}}
+)
+
+// Delta returns the offset of one step in direction d
+func (d Direction) Delta() Point {
+	return Orthogonal[d&3]
+}
+
+// TurnRight returns the direction a quarter turn clockwise from d
+func (d Direction) TurnRight() Direction {
+	return (d + 1) & 3
+}
+
+// TurnLeft returns the direction a quarter turn anticlockwise from d
+func (d Direction) TurnLeft() Direction {
+	return (d + 3) & 3
+}
+
+// Reverse returns the opposite direction
+func (d Direction) Reverse() Direction {
+	return (d + 2) & 3
+}
+
+// String returns the arrow for d, the form ParseDirection reads
+func (d Direction) String() string {
+	return string("^>v<"[d&3])
+}
+
+// ParseDirection reads one of the arrows ^, >, v and <
+func ParseDirection(c byte) (Direction, error) {
+	switch c {
+	case '^':
+		return North, nil
+	case '>':
+		return East, nil
+	case 'v':
+		return South, nil
+	case '<':
+		return West, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDirection, c)
+	}
+}
@@ -0,0 +1,121 @@
+package geom
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPointArithmetic(t *testing.T) {
+	p, q := Point{3, -2}, Point{-1, 5}
+	tests := []struct {
+		name string
+		got  Point
+		want Point
+	}{
+		{name: "Add", got: p.Add(q), want: Point{2, 3}},
+		{name: "Sub", got: p.Sub(q), want: Point{4, -7}},
+		{name: "Scale", got: p.Scale(3), want: Point{9, -6}},
+		{name: "Neg", got: p.Neg(), want: Point{-3, 2}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestDistances(t *testing.T) {
+	tests := []struct {
+		p, q                 Point
+		manhattan, chebyshev int
+	}{
+		{p: Point{0, 0}, q: Point{0, 0}},
+		{p: Point{1, 2}, q: Point{4, 6}, manhattan: 7, chebyshev: 4},
+		{p: Point{-3, 5}, q: Point{2, 0}, manhattan: 10, chebyshev: 5},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Manhattan(tt.q); got != tt.manhattan {
+			t.Errorf("%v.Manhattan(%v) = %d, want %d", tt.p, tt.q, got, tt.manhattan)
+		}
+		if got := tt.p.Chebyshev(tt.q); got != tt.chebyshev {
+			t.Errorf("%v.Chebyshev(%v) = %d, want %d", tt.p, tt.q, got, tt.chebyshev)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		p    Point
+		want Point
+	}{
+		{p: Point{3, 4}, want: Point{3, 4}},
+		{p: Point{11, 7}, want: Point{0, 0}},
+		{p: Point{-1, -1}, want: Point{10, 6}},
+		{p: Point{-23, 15}, want: Point{10, 1}},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Wrap(11, 7); got != tt.want {
+			t.Errorf("%v.Wrap(11, 7) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestParsePoint(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Point
+		wantErr bool
+	}{
+		{s: "6,1", want: Point{6, 1}},
+		{s: " -3 , 12 ", want: Point{-3, 12}},
+		{s: "6", wantErr: true},
+		{s: "a,1", wantErr: true},
+		{s: "1,2,3", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePoint(tt.s)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParsePoint(%q) = %v, %v, want %v, error %v", tt.s, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidPoint) {
+			t.Errorf("ParsePoint(%q) error = %v, want %v", tt.s, err, ErrInvalidPoint)
+		}
+		if back, err := ParsePoint(got.String()); err != nil || back != got {
+			t.Errorf("ParsePoint(%q) = %v, %v, want %v", got.String(), back, err, got)
+		}
+	}
+}
+
+func TestDirections(t *testing.T) {
+	tests := []struct {
+		d                    Direction
+		right, left, reverse Direction
+		delta                Point
+		arrow                byte
+	}{
+		{d: North, right: East, left: West, reverse: South, delta: Point{0, -1}, arrow: '^'},
+		{d: East, right: South, left: North, reverse: West, delta: Point{1, 0}, arrow: '>'},
+		{d: South, right: West, left: East, reverse: North, delta: Point{0, 1}, arrow: 'v'},
+		{d: West, right: North, left: South, reverse: East, delta: Point{-1, 0}, arrow: '<'},
+	}
+	for _, tt := range tests {
+		if got := tt.d.TurnRight(); got != tt.right {
+			t.Errorf("%v.TurnRight() = %v, want %v", tt.d, got, tt.right)
+		}
+		if got := tt.d.TurnLeft(); got != tt.left {
+			t.Errorf("%v.TurnLeft() = %v, want %v", tt.d, got, tt.left)
+		}
+		if got := tt.d.Reverse(); got != tt.reverse {
+			t.Errorf("%v.Reverse() = %v, want %v", tt.d, got, tt.reverse)
+		}
+		if got := tt.d.Delta(); got != tt.delta {
+			t.Errorf("%v.Delta() = %v, want %v", tt.d, got, tt.delta)
+		}
+		if got, err := ParseDirection(tt.arrow); err != nil || got != tt.d || tt.d.String() != string(tt.arrow) {
+			t.Errorf("ParseDirection(%q) = %v, %v; String() = %q", tt.arrow, got, err, tt.d.String())
+		}
+	}
+	if _, err := ParseDirection('x'); !errors.Is(err, ErrInvalidDirection) {
+		t.Errorf("ParseDirection('x') error = %v, want %v", err, ErrInvalidDirection)
+	}
+}
@@ -0,0 +1,219 @@
+// Package grid provides a generic two-dimensional grid stored in a single slice, with
+// bounds-checked access, neighbour iteration, searching, rotation and printing.
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"aoc2024/utility/geom"
+)
+
+var (
+	ErrEmpty  = errors.New("grid has no rows")
+	ErrRagged = errors.New("grid rows have different lengths")
+)
+
+// Point is a cell position: X is the column and Y the row
+type Point = geom.Point
+
+// Grid is a rectangular grid of cells of type T
+type Grid[T any] struct {
+	width, height int
+	cells         []T
+}
+
+// New returns a width x height grid of zero values
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// Parse builds a grid from text lines, converting each byte with convert.
+// Trailing empty lines are ignored; all other lines must have the same length.
+func Parse[T any](lines []string, convert func(byte) (T, error)) (*Grid[T], error) {
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil, ErrEmpty
+	}
+
+	g := New[T](len(lines[0]), len(lines))
+	for y, line := range lines {
+		if len(line) != g.width {
+			return nil, fmt.Errorf("%w: line %d has %d cells, want %d", ErrRagged, y+1, len(line), g.width)
+		}
+		for x := range len(line) {
+			v, err := convert(line[x])
+			if err != nil {
+				return nil, fmt.Errorf("line %d, column %d: %w", y+1, x+1, err)
+			}
+			g.cells[y*g.width+x] = v
+		}
+	}
+	return g, nil
+}
+
+// Bytes builds a grid holding the characters of the lines
+func Bytes(lines []string) (*Grid[byte], error) {
+	return Parse(lines, func(b byte) (byte, error) { return b, nil })
+}
+
+// Width returns the number of columns
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// Size returns the number of cells
+func (g *Grid[T]) Size() int {
+	return len(g.cells)
+}
+
+// InBounds reports whether p is a cell of the grid
+func (g *Grid[T]) InBounds(p Point) bool {
+	return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// Index returns the position of p in row-major order, for indexing flat per-cell slices
+// of length Size. p must be in bounds.
+func (g *Grid[T]) Index(p Point) int {
+	return p.Y*g.width + p.X
+}
+
+// Point returns the cell at row-major index i; the inverse of Index
+func (g *Grid[T]) Point(i int) Point {
+	return Point{X: i % g.width, Y: i / g.width}
+}
+
+// Get returns the value at p, or the zero value and false if p is out of bounds
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[g.Index(p)], true
+}
+
+// At returns the value at p, which must be in bounds
+func (g *Grid[T]) At(p Point) T {
+	if !g.InBounds(p) {
+		panic(fmt.Sprintf("grid: %v out of bounds %dx%d", p, g.width, g.height))
+	}
+	return g.cells[g.Index(p)]
+}
+
+// Set stores v at p and reports whether p was in bounds
+func (g *Grid[T]) Set(p Point, v T) bool {
+	if !g.InBounds(p) {
+		return false
+	}
+	g.cells[g.Index(p)] = v
+	return true
+}
+
+// All iterates over every cell in row-major order
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(g.Point(i), v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors4 iterates over the in-bounds edge neighbours of p
+func (g *Grid[T]) Neighbors4(p Point) iter.Seq2[Point, T] {
+	return g.neighbors(p, geom.Orthogonal[:])
+}
+
+// Neighbors8 iterates over the in-bounds edge and corner neighbours of p
+func (g *Grid[T]) Neighbors8(p Point) iter.Seq2[Point, T] {
+	return g.neighbors(p, geom.Adjacent[:])
+}
+
+func (g *Grid[T]) neighbors(p Point, offsets []Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, d := range offsets {
+			q := p.Add(d)
+			if g.InBounds(q) && !yield(q, g.cells[g.Index(q)]) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first cell, in row-major order, whose value satisfies match
+func (g *Grid[T]) Find(match func(T) bool) (Point, bool) {
+	for i, v := range g.cells {
+		if match(v) {
+			return g.Point(i), true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every cell whose value satisfies match, in row-major order
+func (g *Grid[T]) FindAll(match func(T) bool) []Point {
+	var points []Point
+	for i, v := range g.cells {
+		if match(v) {
+			points = append(points, g.Point(i))
+		}
+	}
+	return points
+}
+
+// Clone returns a copy of the grid
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{width: g.width, height: g.height, cells: append([]T(nil), g.cells...)}
+}
+
+// Rotate returns a copy of the grid turned a quarter clockwise
+func (g *Grid[T]) Rotate() *Grid[T] {
+	r := New[T](g.height, g.width)
+	for i, v := range g.cells {
+		p := g.Point(i)
+		r.cells[r.Index(Point{X: g.height - 1 - p.Y, Y: p.X})] = v
+	}
+	return r
+}
+
+// Format renders the grid with one line per row, converting each cell with cell
+func (g *Grid[T]) Format(cell func(T) string) string {
+	var sb strings.Builder
+	for i, v := range g.cells {
+		sb.WriteString(cell(v))
+		if (i+1)%g.width == 0 {
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+// String renders the grid with one line per row. Byte and rune cells are printed as
+// characters, booleans as # and ., and anything else with fmt.Sprint.
+func (g *Grid[T]) String() string {
+	return g.Format(func(v T) string {
+		switch c := any(v).(type) {
+		case byte:
+			return string(c)
+		case rune:
+			return string(c)
+		case bool:
+			if c {
+				return "#"
+			}
+			return "."
+		default:
+			return fmt.Sprint(c)
+		}
+	})
+}
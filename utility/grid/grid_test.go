@@ -0,0 +1,152 @@
+package grid
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	g, err := Parse([]string{"123", "456", ""}, func(b byte) (int, error) {
+		return strconv.Atoi(string(b))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Width() != 3 || g.Height() != 2 || g.Size() != 6 {
+		t.Errorf("size = %dx%d (%d cells), want 3x2 (6 cells)", g.Width(), g.Height(), g.Size())
+	}
+	if got := g.At(Point{X: 2, Y: 1}); got != 6 {
+		t.Errorf("At(2, 1) = %d, want 6", got)
+	}
+
+	tests := []struct {
+		name  string
+		lines []string
+		want  error
+	}{
+		{name: "empty", lines: []string{"", ""}, want: ErrEmpty},
+		{name: "ragged", lines: []string{"12", "3"}, want: ErrRagged},
+		{name: "bad cell", lines: []string{"1x"}, want: strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.lines, func(b byte) (int, error) { return strconv.Atoi(string(b)) })
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: Parse() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestAccess(t *testing.T) {
+	g := New[int](3, 2)
+	tests := []struct {
+		p      Point
+		wantOK bool
+	}{
+		{p: Point{X: 0, Y: 0}, wantOK: true},
+		{p: Point{X: 2, Y: 1}, wantOK: true},
+		{p: Point{X: 3, Y: 0}},
+		{p: Point{X: 0, Y: 2}},
+		{p: Point{X: -1, Y: 0}},
+	}
+	for _, tt := range tests {
+		if ok := g.Set(tt.p, 7); ok != tt.wantOK {
+			t.Errorf("Set(%v) = %v, want %v", tt.p, ok, tt.wantOK)
+		}
+		v, ok := g.Get(tt.p)
+		if ok != tt.wantOK || (ok && v != 7) || (!ok && v != 0) {
+			t.Errorf("Get(%v) = %d, %v", tt.p, v, ok)
+		}
+		if tt.wantOK && g.Point(g.Index(tt.p)) != tt.p {
+			t.Errorf("Point(Index(%v)) = %v", tt.p, g.Point(g.Index(tt.p)))
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g, err := Bytes([]string{"abc", "def", "ghi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collect := func(it func(func(Point, byte) bool)) string {
+		var s []byte
+		for _, c := range it {
+			s = append(s, c)
+		}
+		return string(s)
+	}
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "Neighbors4 centre", got: collect(g.Neighbors4(Point{X: 1, Y: 1})), want: "bfhd"},
+		{name: "Neighbors4 corner", got: collect(g.Neighbors4(Point{X: 0, Y: 0})), want: "bd"},
+		{name: "Neighbors8 centre", got: collect(g.Neighbors8(Point{X: 1, Y: 1})), want: "bcfihgda"},
+		{name: "Neighbors8 edge", got: collect(g.Neighbors8(Point{X: 2, Y: 1})), want: "ciheb"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	g, err := Bytes([]string{"#.#", "..#"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	isWall := func(c byte) bool { return c == '#' }
+
+	if p, ok := g.Find(isWall); !ok || p != (Point{X: 0, Y: 0}) {
+		t.Errorf("Find() = %v, %v, want (0, 0)", p, ok)
+	}
+	if _, ok := g.Find(func(c byte) bool { return c == 'S' }); ok {
+		t.Error("Find() found a missing value")
+	}
+	want := []Point{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}}
+	if got := g.FindAll(isWall); !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %v, want %v", got, want)
+	}
+}
+
+func TestRotateAndString(t *testing.T) {
+	g, err := Bytes([]string{"abc", "def"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		turns int
+		want  string
+	}{
+		{turns: 0, want: "abc\ndef\n"},
+		{turns: 1, want: "da\neb\nfc\n"},
+		{turns: 2, want: "fed\ncba\n"},
+		{turns: 4, want: "abc\ndef\n"},
+	}
+	for _, tt := range tests {
+		r := g
+		for range tt.turns {
+			r = r.Rotate()
+		}
+		if got := r.String(); got != tt.want {
+			t.Errorf("%d rotations = %q, want %q", tt.turns, got, tt.want)
+		}
+	}
+
+	clone := g.Clone()
+	clone.Set(Point{X: 0, Y: 0}, 'z')
+	if g.At(Point{X: 0, Y: 0}) != 'a' {
+		t.Error("Clone() shares cells with the original")
+	}
+
+	walls := New[bool](2, 1)
+	walls.Set(Point{X: 1, Y: 0}, true)
+	if got := walls.String(); got != ".#\n" {
+		t.Errorf("bool grid String() = %q, want %q", got, ".#\n")
+	}
+}
@@ -0,0 +1,75 @@
+package utility
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// Year is the Advent of Code event the puzzles belong to
+	Year = 2024
+
+	// DefaultInputDir is where puzzle inputs are kept when AOC_INPUT_DIR is not set
+	DefaultInputDir = "inputs"
+
+	// Stdin is the path that makes ReadFile read from standard input
+	Stdin = "-"
+
+	maxLineLength = 1024 * 1024
+)
+
+// InputDir returns the configured inputs directory, taken from the AOC_INPUT_DIR
+// environment variable and falling back to DefaultInputDir
+func InputDir() string {
+	if dir := os.Getenv("AOC_INPUT_DIR"); dir != "" {
+		return dir
+	}
+	return DefaultInputDir
+}
+
+// InputPath returns the location of a day's input inside dir, e.g. inputs/2024/day07.txt
+func InputPath(dir string, day int) string {
+	return filepath.Join(dir, strconv.Itoa(Year), fmt.Sprintf("day%02d.txt", day))
+}
+
+// ReadDayInput reads a day's input from the inputs directory dir
+func ReadDayInput(dir string, day int) ([]string, error) {
+	return ReadFile(InputPath(dir, day))
+}
+
+// ReadFile reads the lines of the file at path, or of standard input if path is Stdin
+func ReadFile(path string) (lines []string, err error) {
+	if path == Stdin {
+		return ReadLines(os.Stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func(file *os.File) {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}(file)
+
+	return ReadLines(file)
+}
+
+// ReadLines reads all lines from r without their line endings
+func ReadLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineLength)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
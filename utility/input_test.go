@@ -0,0 +1,106 @@
+package utility
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    []string
+		wantErr error
+	}{
+		{name: "empty", text: "", want: nil},
+		{name: "no trailing newline", text: "a\nb", want: []string{"a", "b"}},
+		{name: "trailing newline", text: "a\nb\n", want: []string{"a", "b"}},
+		{name: "blank lines", text: "a\n\nb\n\n", want: []string{"a", "", "b", ""}},
+		{name: "CRLF", text: "a\r\nb\r\n", want: []string{"a", "b"}},
+		{name: "line too long", text: strings.Repeat("x", maxLineLength+1), wantErr: bufio.ErrTooLong},
+	}
+
+	for _, tt := range tests {
+		got, err := ReadLines(strings.NewReader(tt.text))
+		if !errors.Is(err, tt.wantErr) || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ReadLines() = %q, %v, want %q, %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte("1 2\r\n3 4\r\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stdin, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stdin.Close()
+	saved := os.Stdin
+	os.Stdin = stdin
+	defer func() { os.Stdin = saved }()
+
+	tests := []struct {
+		name    string
+		path    string
+		want    []string
+		wantErr error
+	}{
+		{name: "file", path: path, want: []string{"1 2", "3 4"}},
+		{name: "stdin", path: Stdin, want: []string{"1 2", "3 4"}},
+		{name: "missing file", path: filepath.Join(t.TempDir(), "missing.txt"), wantErr: fs.ErrNotExist},
+	}
+
+	for _, tt := range tests {
+		got, err := ReadFile(tt.path)
+		if !errors.Is(err, tt.wantErr) || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: ReadFile(%q) = %q, %v, want %q, %v", tt.name, tt.path, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestInputPath(t *testing.T) {
+	tests := []struct {
+		name string
+		env  string
+		day  int
+		want string
+	}{
+		{name: "default", env: "", day: 7, want: filepath.Join("inputs", "2024", "day07.txt")},
+		{name: "AOC_INPUT_DIR", env: "/tmp/aoc", day: 7, want: filepath.Join("/tmp/aoc", "2024", "day07.txt")},
+		{name: "two digit day", env: "data", day: 24, want: filepath.Join("data", "2024", "day24.txt")},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("AOC_INPUT_DIR", tt.env)
+		if got := InputPath(InputDir(), tt.day); got != tt.want {
+			t.Errorf("%s: InputPath(InputDir(), %d) = %q, want %q", tt.name, tt.day, got, tt.want)
+		}
+	}
+}
+
+func TestReadDayInput(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "2024"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "2024", "day03.txt"), []byte("mul(2,4)\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AOC_INPUT_DIR", dir)
+
+	if got, err := ReadDayInput(InputDir(), 3); err != nil || !reflect.DeepEqual(got, []string{"mul(2,4)"}) {
+		t.Errorf("ReadDayInput(3) = %q, %v, want [mul(2,4)]", got, err)
+	}
+	if _, err := ReadDayInput(InputDir(), 4); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadDayInput(4) error = %v, want %v", err, fs.ErrNotExist)
+	}
+}
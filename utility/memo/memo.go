@@ -0,0 +1,156 @@
+// Package memo caches the results of pure functions, including recursive ones, with an
+// optional bound on the number of entries and hit/miss counts for judging how well the
+// cache works. Caches are safe for concurrent use.
+package memo
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// Stats counts how a cache has been used
+type Stats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+	// Size is the number of entries held when the stats were taken
+	Size int
+}
+
+// HitRate returns the fraction of lookups answered from the cache
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// String summarises the stats, e.g. "120 hits, 40 misses (75.0%), 0 evictions, 40 entries"
+func (s Stats) String() string {
+	return fmt.Sprintf("%d hits, %d misses (%.1f%%), %d evictions, %d entries",
+		s.Hits, s.Misses, 100*s.HitRate(), s.Evictions, s.Size)
+}
+
+// Cache maps keys to values. When it has a limit it evicts the least recently used
+// entry to make room for a new one.
+type Cache[K comparable, V any] struct {
+	mu    sync.Mutex
+	limit int
+	items map[K]*list.Element
+	order *list.List // most recently used at the front
+	stats Stats
+}
+
+// entry is a key and value held in a Cache's order list
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewCache returns a cache holding at most limit entries, or any number if limit is 0
+func NewCache[K comparable, V any](limit int) *Cache[K, V] {
+	return &Cache[K, V]{limit: limit, items: make(map[K]*list.Element), order: list.New()}
+}
+
+// Get returns the value stored for key and counts the lookup as a hit or a miss
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e)
+	return e.Value.(*entry[K, V]).value, true
+}
+
+// Put stores value for key, evicting the least recently used entry if the cache is full
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		e.Value.(*entry[K, V]).value = value
+		c.order.MoveToFront(e)
+		return
+	}
+	if c.limit > 0 && c.order.Len() >= c.limit {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry[K, V]).key)
+		c.stats.Evictions++
+	}
+	c.items[key] = c.order.PushFront(&entry[K, V]{key, value})
+}
+
+// Len returns the number of entries in the cache
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Stats returns the cache's usage so far
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Size = len(c.items)
+	return s
+}
+
+// Reset empties the cache and clears its stats
+func (c *Cache[K, V]) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.items)
+	c.order.Init()
+	c.stats = Stats{}
+}
+
+// Func is a memoized function from K to V
+type Func[K comparable, V any] struct {
+	cache *Cache[K, V]
+	fn    func(recurse func(K) V, key K) V
+}
+
+// New memoizes fn, caching at most limit results (any number if limit is 0). fn
+// receives the memoized function as recurse, so recursive calls are cached too:
+//
+//	fib := memo.New(0, func(fib func(int) int, n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return fib(n-1) + fib(n-2)
+//	})
+//
+// The cache is not locked while fn runs, so callers racing on the same key may each
+// compute it; fn must therefore be pure.
+func New[K comparable, V any](limit int, fn func(recurse func(K) V, key K) V) *Func[K, V] {
+	return &Func[K, V]{cache: NewCache[K, V](limit), fn: fn}
+}
+
+// Call returns fn(key), computing it only if it is not cached
+func (f *Func[K, V]) Call(key K) V {
+	if v, ok := f.cache.Get(key); ok {
+		return v
+	}
+	v := f.fn(f.Call, key)
+	f.cache.Put(key, v)
+	return v
+}
+
+// Stats returns the usage of the function's cache
+func (f *Func[K, V]) Stats() Stats {
+	return f.cache.Stats()
+}
+
+// Reset forgets every cached result
+func (f *Func[K, V]) Reset() {
+	f.cache.Reset()
+}
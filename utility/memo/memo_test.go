@@ -0,0 +1,91 @@
+package memo
+
+import (
+	"sync"
+	"testing"
+)
+
+func newFib() *Func[int, int] {
+	return New(0, func(fib func(int) int, n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+}
+
+func TestFunc(t *testing.T) {
+	fib := newFib()
+	if got := fib.Call(90); got != 2880067194370816120 {
+		t.Errorf("fib(90) = %d, want 2880067194370816120", got)
+	}
+	// Each of fib(0) to fib(90) is computed once; every other call is a hit
+	want := Stats{Hits: 88, Misses: 91, Size: 91}
+	if got := fib.Stats(); got != want {
+		t.Errorf("Stats() = %v, want %v", got, want)
+	}
+
+	fib.Reset()
+	if got := fib.Stats(); got != (Stats{}) {
+		t.Errorf("Stats() after Reset = %v, want zero", got)
+	}
+}
+
+func TestBoundedCache(t *testing.T) {
+	c := NewCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // b is now the least recently used
+	c.Put("c", 3)
+
+	for _, tt := range []struct {
+		key    string
+		want   int
+		wantOK bool
+	}{
+		{key: "a", want: 1, wantOK: true},
+		{key: "b"},
+		{key: "c", want: 3, wantOK: true},
+	} {
+		if got, ok := c.Get(tt.key); got != tt.want || ok != tt.wantOK {
+			t.Errorf("Get(%q) = %d, %v, want %d, %v", tt.key, got, ok, tt.want, tt.wantOK)
+		}
+	}
+	if s := c.Stats(); s.Evictions != 1 || s.Size != 2 || s.Hits != 3 || s.Misses != 1 {
+		t.Errorf("Stats() = %v, want 3 hits, 1 miss, 1 eviction, 2 entries", s)
+	}
+}
+
+func TestBoundedFunc(t *testing.T) {
+	// A cache too small for the recursion still gives the right answer
+	fib := New(3, func(fib func(int) int, n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+	if got := fib.Call(30); got != 832040 {
+		t.Errorf("fib(30) = %d, want 832040", got)
+	}
+	if s := fib.Stats(); s.Size != 3 || s.Evictions == 0 {
+		t.Errorf("Stats() = %v, want 3 entries and some evictions", s)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	fib := newFib()
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := fib.Call(50 + i); got <= 0 {
+				t.Errorf("fib(%d) = %d", 50+i, got)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := fib.Call(57); got != 365435296162 {
+		t.Errorf("fib(57) = %d, want 365435296162", got)
+	}
+}
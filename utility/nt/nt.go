@@ -0,0 +1,176 @@
+// Package nt provides number theory for integer puzzles: greatest common divisors,
+// modular inverses, the Chinese Remainder Theorem and exact solving of small linear
+// systems.
+package nt
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"aoc2024/utility"
+)
+
+var (
+	ErrNoInverse      = errors.New("no modular inverse")
+	ErrNoSolution     = errors.New("congruences have no common solution")
+	ErrSingular       = errors.New("linear system has no unique solution")
+	ErrInvalidModulus = errors.New("modulus must be positive")
+)
+
+// GCD returns the greatest common divisor of a and b, which is never negative.
+// GCD(0, 0) is 0.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return utility.Abs(a)
+}
+
+// LCM returns the least common multiple of a and b, which is never negative, or
+// ErrOverflow if it does not fit in an int. LCM(0, n) is 0.
+func LCM(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	return utility.MulChecked(utility.Abs(a/GCD(a, b)), utility.Abs(b))
+}
+
+// ExtendedGCD returns g = gcd(a, b) and Bézout coefficients x and y with a*x + b*y = g
+func ExtendedGCD(a, b int) (g, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// Mod returns a modulo m in the range [0, m) for positive m
+func Mod(a, m int) int {
+	r := a % m
+	if r < 0 {
+		r += m
+	}
+	return r
+}
+
+// ModInverse returns the x in [0, m) with a*x ≡ 1 (mod m), or ErrNoInverse if a and m
+// share a factor. m must be positive.
+func ModInverse(a, m int) (int, error) {
+	if m < 1 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidModulus, m)
+	}
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%w: %d mod %d", ErrNoInverse, a, m)
+	}
+	return Mod(x, m), nil
+}
+
+// CRT solves the congruences x ≡ residues[i] (mod moduli[i]). It returns the smallest
+// non-negative solution x and the modulus m, the lcm of the moduli, that all solutions
+// are congruent under. The moduli must be positive but need not be coprime; if the
+// congruences conflict it returns ErrNoSolution.
+func CRT(residues, moduli []int) (x, m int, err error) {
+	if len(residues) != len(moduli) {
+		return 0, 0, fmt.Errorf("%w: %d residues for %d moduli", ErrNoSolution, len(residues), len(moduli))
+	}
+
+	for _, n := range moduli {
+		if n < 1 {
+			return 0, 0, fmt.Errorf("%w: %d", ErrInvalidModulus, n)
+		}
+	}
+
+	x, m = 0, 1
+	for i, n := range moduli {
+		r := Mod(residues[i], n)
+		// Find k with x + m*k ≡ r (mod n), so m*k ≡ r - x (mod n)
+		g, inv, _ := ExtendedGCD(m, n)
+		if (r-x)%g != 0 {
+			return 0, 0, fmt.Errorf("%w: x ≡ %d (mod %d) and x ≡ %d (mod %d)", ErrNoSolution, x, m, r, n)
+		}
+		step := n / g
+		k := mulMod((r-x)/g, inv, step)
+		if m, err = utility.MulChecked(m, step); err != nil {
+			return 0, 0, err
+		}
+		x = Mod(x+(m/step)*k, m)
+	}
+	return x, m, nil
+}
+
+// mulMod returns a·b mod m in [0, m), going through big.Int when the product itself
+// would overflow an int
+func mulMod(a, b, m int) int {
+	a, b = Mod(a, m), Mod(b, m)
+	if p, err := utility.MulChecked(a, b); err == nil {
+		return p % m
+	}
+	p := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	return int(p.Mod(p, big.NewInt(int64(m))).Int64())
+}
+
+// SolveLinear solves a·v = b exactly for square a, returning v as rationals. It returns
+// ErrSingular when the system has no solution or infinitely many.
+func SolveLinear(a [][]int64, b []int64) ([]*big.Rat, error) {
+	n := len(a)
+	if len(b) != n {
+		return nil, fmt.Errorf("%w: %d equations for %d results", ErrSingular, n, len(b))
+	}
+
+	// Augmented matrix [a | b], reduced in place by Gauss-Jordan elimination
+	rows := make([][]*big.Rat, n)
+	for i, row := range a {
+		if len(row) != n {
+			return nil, fmt.Errorf("%w: row %d has %d coefficients, want %d", ErrSingular, i+1, len(row), n)
+		}
+		rows[i] = make([]*big.Rat, n+1)
+		for j, v := range row {
+			rows[i][j] = new(big.Rat).SetInt64(v)
+		}
+		rows[i][n] = new(big.Rat).SetInt64(b[i])
+	}
+
+	for col := range n {
+		pivot := -1
+		for r := col; r < n; r++ {
+			if rows[r][col].Sign() != 0 {
+				pivot = r
+				break
+			}
+		}
+		if pivot == -1 {
+			return nil, ErrSingular
+		}
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+
+		inv := new(big.Rat).Inv(rows[col][col])
+		for j := col; j <= n; j++ {
+			rows[col][j].Mul(rows[col][j], inv)
+		}
+		for r := range n {
+			if r == col || rows[r][col].Sign() == 0 {
+				continue
+			}
+			factor := new(big.Rat).Set(rows[r][col])
+			for j := col; j <= n; j++ {
+				rows[r][j].Sub(rows[r][j], new(big.Rat).Mul(factor, rows[col][j]))
+			}
+		}
+	}
+
+	v := make([]*big.Rat, n)
+	for i := range n {
+		v[i] = rows[i][n]
+	}
+	return v, nil
+}
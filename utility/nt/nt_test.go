@@ -0,0 +1,120 @@
+package nt
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"aoc2024/utility"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		a, b     int
+		gcd, lcm int
+	}{
+		{a: 12, b: 18, gcd: 6, lcm: 36},
+		{a: -4, b: 6, gcd: 2, lcm: 12},
+		{a: 7, b: 0, gcd: 7, lcm: 0},
+		{a: 0, b: 0, gcd: 0, lcm: 0},
+		{a: 101, b: 103, gcd: 1, lcm: 10403},
+	}
+
+	for _, tt := range tests {
+		if got := GCD(tt.a, tt.b); got != tt.gcd {
+			t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.gcd)
+		}
+		if got, err := LCM(tt.a, tt.b); err != nil || got != tt.lcm {
+			t.Errorf("LCM(%d, %d) = %d, %v, want %d", tt.a, tt.b, got, err, tt.lcm)
+		}
+		g, x, y := ExtendedGCD(tt.a, tt.b)
+		if g != tt.gcd || tt.a*x+tt.b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d", tt.a, tt.b, g, x, y)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	if got, err := ModInverse(3, 11); err != nil || got != 4 {
+		t.Errorf("ModInverse(3, 11) = %d, %v, want 4", got, err)
+	}
+	if got, err := ModInverse(-3, 11); err != nil || got != 7 {
+		t.Errorf("ModInverse(-3, 11) = %d, %v, want 7", got, err)
+	}
+	if _, err := ModInverse(6, 9); !errors.Is(err, ErrNoInverse) {
+		t.Errorf("ModInverse(6, 9) error = %v, want %v", err, ErrNoInverse)
+	}
+	if _, err := ModInverse(3, 0); !errors.Is(err, ErrInvalidModulus) {
+		t.Errorf("ModInverse(3, 0) error = %v, want %v", err, ErrInvalidModulus)
+	}
+}
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		a, m, want int
+	}{
+		{a: 7, m: 5, want: 2},
+		{a: -7, m: 5, want: 3},
+		{a: -5, m: 5, want: 0},
+		// Adding the modulus to the remainder must not overflow
+		{a: -1, m: math.MaxInt, want: math.MaxInt - 1},
+	}
+
+	for _, tt := range tests {
+		if got := Mod(tt.a, tt.m); got != tt.want {
+			t.Errorf("Mod(%d, %d) = %d, want %d", tt.a, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		residues, moduli []int
+		want, wantMod    int
+		wantErr          error
+	}{
+		{residues: []int{2, 3, 2}, moduli: []int{3, 5, 7}, want: 23, wantMod: 105},
+		{residues: []int{7000 % 101, 7000 % 103}, moduli: []int{101, 103}, want: 7000, wantMod: 10403},
+		// Moduli sharing a factor
+		{residues: []int{3, 5}, moduli: []int{4, 6}, want: 11, wantMod: 12},
+		{residues: []int{-1}, moduli: []int{5}, want: 4, wantMod: 5},
+		// Coprime moduli whose product fits an int but whose squares do not
+		{residues: []int{1234567890123456789 % 2147483647, 1234567890123456789 % 4294967291},
+			moduli: []int{2147483647, 4294967291}, want: 1234567890123456789, wantMod: 2147483647 * 4294967291},
+		{residues: []int{1, 2}, moduli: []int{4294967291, 4294967279}, wantErr: utility.ErrOverflow},
+		{residues: []int{1, 2}, moduli: []int{4, 6}, wantErr: ErrNoSolution},
+		{residues: []int{1, 2}, moduli: []int{3, 0}, wantErr: ErrInvalidModulus},
+		{residues: []int{1}, moduli: []int{-5}, wantErr: ErrInvalidModulus},
+	}
+
+	for _, tt := range tests {
+		got, m, err := CRT(tt.residues, tt.moduli)
+		if got != tt.want || m != tt.wantMod || !errors.Is(err, tt.wantErr) {
+			t.Errorf("CRT(%v, %v) = %d, %d, %v, want %d, %d, %v",
+				tt.residues, tt.moduli, got, m, err, tt.want, tt.wantMod, tt.wantErr)
+		}
+	}
+}
+
+func TestSolveLinear(t *testing.T) {
+	// 94a + 22b = 8400, 34a + 67b = 5400
+	v, err := SolveLinear([][]int64{{94, 22}, {34, 67}}, []int64{8400, 5400})
+	if err != nil || v[0].Cmp(big.NewRat(80, 1)) != 0 || v[1].Cmp(big.NewRat(40, 1)) != 0 {
+		t.Errorf("SolveLinear() = %v, %v, want [80 40]", v, err)
+	}
+
+	// The first column needs a row swap, and the answer is not whole
+	v, err = SolveLinear([][]int64{{0, 2, 1}, {1, 0, 0}, {0, 0, 3}}, []int64{1, 4, 1})
+	want := []*big.Rat{big.NewRat(4, 1), big.NewRat(1, 3), big.NewRat(1, 3)}
+	for i := range want {
+		if err != nil || v[i].Cmp(want[i]) != 0 {
+			t.Errorf("SolveLinear() = %v, %v, want %v", v, err, want)
+			break
+		}
+	}
+
+	if _, err := SolveLinear([][]int64{{1, 2}, {2, 4}}, []int64{3, 6}); !errors.Is(err, ErrSingular) {
+		t.Errorf("SolveLinear() of dependent rows error = %v, want %v", err, ErrSingular)
+	}
+}
@@ -0,0 +1,160 @@
+// Package parse reads the common shapes of puzzle input: sections separated by blank
+// lines, lines of records, integers embedded in text or in lines of a fixed format and blocks of
+// characters. Errors
+// carry the number of the input line they were found on.
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"aoc2024/utility"
+	"aoc2024/utility/grid"
+)
+
+var (
+	// ErrCount is returned when a line holds a different number of values than expected
+	ErrCount = errors.New("wrong number of values")
+	// ErrFormat is returned when a line does not have the expected format
+	ErrFormat = errors.New("line does not match the expected format")
+)
+
+var intRegex = regexp.MustCompile(`-?\d+`)
+
+// LineError reports a problem with one line of input
+type LineError struct {
+	// Line is the number of the line in the input, counting from 1
+	Line int
+	Text string
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d %q: %v", e.Line, e.Text, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// Section is a run of lines with no blank line between them
+type Section struct {
+	// Start is the number of the section's first line in the input, counting from 1
+	Start int
+	Lines []string
+}
+
+// Input returns the whole of lines as one section
+func Input(lines []string) Section {
+	return Section{Start: 1, Lines: lines}
+}
+
+// Sections splits lines at blank lines. Lines holding only whitespace count as blank,
+// and runs of blank lines, including leading and trailing ones, are dropped.
+func Sections(lines []string) []Section {
+	var sections []Section
+	start := -1
+	for i, line := range lines {
+		blank := strings.TrimSpace(line) == ""
+		switch {
+		case !blank && start == -1:
+			start = i
+		case blank && start != -1:
+			sections = append(sections, Section{Start: start + 1, Lines: lines[start:i]})
+			start = -1
+		}
+	}
+	if start != -1 {
+		sections = append(sections, Section{Start: start + 1, Lines: lines[start:]})
+	}
+	return sections
+}
+
+// Ints returns every integer in s, in order. A minus sign directly before the digits
+// makes a number negative; any other character separates numbers.
+func Ints(s string) ([]int, error) {
+	matches := intRegex.FindAllString(s, -1)
+	ints := make([]int, len(matches))
+	for i, m := range matches {
+		n, err := strconv.Atoi(m)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", utility.ErrInvalidNumber, m)
+		}
+		ints[i] = n
+	}
+	return ints, nil
+}
+
+// IntsN is Ints for a line that must hold exactly n integers
+func IntsN(s string, n int) ([]int, error) {
+	ints, err := Ints(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(ints) != n {
+		return nil, fmt.Errorf("%w: found %d, want %d", ErrCount, len(ints), n)
+	}
+	return ints, nil
+}
+
+// Match returns the integers captured by re's groups, in order. Unlike Ints, which skips
+// whatever lies between the numbers, Match requires re to match the whole of s, so stray
+// text anywhere on the line is reported as ErrFormat.
+func Match(re *regexp.Regexp, s string) ([]int, error) {
+	m := re.FindStringSubmatch(s)
+	if m == nil || m[0] != s {
+		return nil, fmt.Errorf("%w: want %s", ErrFormat, re)
+	}
+	ints := make([]int, len(m)-1)
+	for i, g := range m[1:] {
+		n, err := strconv.Atoi(g)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", utility.ErrInvalidNumber, g)
+		}
+		ints[i] = n
+	}
+	return ints, nil
+}
+
+// Records scans each non-blank line of s with scan. The first failure is returned as a
+// *LineError giving the line's number in the input.
+func Records[T any](s Section, scan func(line string) (T, error)) ([]T, error) {
+	records := make([]T, 0, len(s.Lines))
+	for i, line := range s.Lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		r, err := scan(line)
+		if err != nil {
+			return nil, &LineError{Line: s.Start + i, Text: line, Err: err}
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// Block reads s as a grid of characters
+func Block(s Section) (*grid.Grid[byte], error) {
+	g, err := grid.Bytes(s.Lines)
+	if err != nil {
+		return nil, fmt.Errorf("block at line %d: %w", s.Start, err)
+	}
+	return g, nil
+}
+
+// Blocks reads every section of lines as a grid of characters
+func Blocks(lines []string) ([]*grid.Grid[byte], error) {
+	sections := Sections(lines)
+	blocks := make([]*grid.Grid[byte], len(sections))
+	for i, s := range sections {
+		g, err := Block(s)
+		if err != nil {
+			return nil, err
+		}
+		blocks[i] = g
+	}
+	return blocks, nil
+}
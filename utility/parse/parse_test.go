@@ -0,0 +1,102 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"aoc2024/utility"
+	"aoc2024/utility/grid"
+)
+
+func TestSections(t *testing.T) {
+	lines := []string{"", "a", "b", "", "  ", "c", "", ""}
+	want := []Section{
+		{Start: 2, Lines: []string{"a", "b"}},
+		{Start: 6, Lines: []string{"c"}},
+	}
+	if got := Sections(lines); !reflect.DeepEqual(got, want) {
+		t.Errorf("Sections() = %v, want %v", got, want)
+	}
+	if got := Sections([]string{"", ""}); len(got) != 0 {
+		t.Errorf("Sections() of blank lines = %v, want none", got)
+	}
+}
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    []int
+		wantErr error
+	}{
+		{s: "p=0,4 v=3,-3", want: []int{0, 4, 3, -3}},
+		{s: "Button A: X+94, Y+34", want: []int{94, 34}},
+		{s: "190: 10 19", want: []int{190, 10, 19}},
+		{s: "no numbers", want: []int{}},
+		{s: "99999999999999999999", wantErr: utility.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		got, err := Ints(tt.s)
+		if !errors.Is(err, tt.wantErr) || (err == nil && !reflect.DeepEqual(got, tt.want)) {
+			t.Errorf("Ints(%q) = %v, %v, want %v, %v", tt.s, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if _, err := IntsN("1,2,3", 2); !errors.Is(err, ErrCount) {
+		t.Errorf("IntsN() error = %v, want %v", err, ErrCount)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	re := regexp.MustCompile(`^p=(-?\d+),(-?\d+) v=(-?\d+),(-?\d+)$`)
+	tests := []struct {
+		s       string
+		want    []int
+		wantErr error
+	}{
+		{s: "p=0,4 v=3,-3", want: []int{0, 4, 3, -3}},
+		{s: "p=0,4 v=3,-3x", wantErr: ErrFormat},
+		{s: " p=0,4 v=3,-3", wantErr: ErrFormat},
+		{s: "p=0,4 v=3", wantErr: ErrFormat},
+		{s: "p=0,4 v=3,-3,1", wantErr: ErrFormat},
+		{s: "p=0,4 v=3,99999999999999999999", wantErr: utility.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		got, err := Match(re, tt.s)
+		if !errors.Is(err, tt.wantErr) || (err == nil && !reflect.DeepEqual(got, tt.want)) {
+			t.Errorf("Match(%q) = %v, %v, want %v, %v", tt.s, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestRecords(t *testing.T) {
+	sections := Sections([]string{"header", "", "1", "", "2", "x"})
+	got, err := Records(sections[1], strconv.Atoi)
+	if err != nil || !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Records() = %v, %v, want [1]", got, err)
+	}
+
+	_, err = Records(sections[2], strconv.Atoi)
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 6 || lineErr.Text != "x" || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Records() error = %v, want a syntax error on line 6", err)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	blocks, err := Blocks([]string{"#.", ".#", "", "ab", "cd", "ef"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 2 || blocks[0].String() != "#.\n.#\n" || blocks[1].Height() != 3 {
+		t.Errorf("Blocks() = %v", blocks)
+	}
+
+	if _, err := Blocks([]string{"ab", "", "cd", "e"}); !errors.Is(err, grid.ErrRagged) {
+		t.Errorf("Blocks() error = %v, want %v", err, grid.ErrRagged)
+	}
+}
@@ -0,0 +1,174 @@
+// Package search implements breadth-first search, Dijkstra and A* over any comparable
+// state type. Every search records, for each state it settles, all the predecessors that
+// reach it at its best cost, so the union of all shortest paths can be recovered as well
+// as a single one.
+package search
+
+import (
+	"iter"
+
+	"aoc2024/utility/container"
+)
+
+// Result holds the distances and the predecessor DAG found by a search
+type Result[S comparable] struct {
+	// Dist is the best known cost of reaching each visited state
+	Dist map[S]int
+	// Prev lists, for each state, the states it is reached from at the cost in Dist.
+	// Start states have no predecessors.
+	Prev map[S][]S
+	// Goals are the goal states reached at the lowest cost, in the order they were found
+	Goals []S
+}
+
+func newResult[S comparable]() *Result[S] {
+	return &Result[S]{Dist: make(map[S]int), Prev: make(map[S][]S)}
+}
+
+// Found reports whether any goal was reached
+func (r *Result[S]) Found() bool {
+	return len(r.Goals) > 0
+}
+
+// Cost returns the cost of reaching the goals, or -1 if none was reached
+func (r *Result[S]) Cost() int {
+	if !r.Found() {
+		return -1
+	}
+	return r.Dist[r.Goals[0]]
+}
+
+// Path returns one cheapest path from a start state to s, both included, or nil if s was
+// not reached
+func (r *Result[S]) Path(s S) []S {
+	if _, ok := r.Dist[s]; !ok {
+		return nil
+	}
+	path := []S{s}
+	for prev := r.Prev[s]; len(prev) > 0; prev = r.Prev[prev[0]] {
+		path = append(path, prev[0])
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// OnShortestPaths returns every state that lies on some cheapest path to one of the
+// given states, or to the goals when none are given
+func (r *Result[S]) OnShortestPaths(ends ...S) container.Set[S] {
+	if len(ends) == 0 {
+		ends = r.Goals
+	}
+	seen := container.NewSet[S]()
+	var stack []S
+	for _, s := range ends {
+		if _, ok := r.Dist[s]; ok {
+			stack = append(stack, s)
+		}
+	}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen.Contains(s) {
+			continue
+		}
+		seen.Add(s)
+		stack = append(stack, r.Prev[s]...)
+	}
+	return seen
+}
+
+// BFS searches outwards from start, where every move costs 1. It stops once every state
+// at the distance of the nearest goal has been found; with a nil goal it visits every
+// reachable state.
+func BFS[S comparable](start S, neighbors func(S) iter.Seq[S], goal func(S) bool) *Result[S] {
+	r := newResult[S]()
+	r.Dist[start] = 0
+	best := -1
+	var queue container.Deque[S]
+	queue.PushBack(start)
+	for queue.Len() > 0 {
+		s, _ := queue.PopFront()
+		d := r.Dist[s]
+		if best != -1 && d > best {
+			break
+		}
+		if goal != nil && goal(s) {
+			best = d
+			r.Goals = append(r.Goals, s)
+			continue
+		}
+		for next := range neighbors(s) {
+			nd, seen := r.Dist[next]
+			switch {
+			case !seen:
+				r.Dist[next] = d + 1
+				r.Prev[next] = []S{s}
+				queue.PushBack(next)
+			case nd == d+1:
+				r.Prev[next] = append(r.Prev[next], s)
+			}
+		}
+	}
+	return r
+}
+
+// Dijkstra finds the cheapest paths from start, where neighbors yields each next state
+// with the non-negative cost of moving to it. It stops once every goal reachable at the
+// lowest cost has been found; with a nil goal it visits every reachable state.
+func Dijkstra[S comparable](start S, neighbors func(S) iter.Seq2[S, int], goal func(S) bool) *Result[S] {
+	return AStar(start, neighbors, nil, goal)
+}
+
+// AStar is Dijkstra guided by heuristic, an estimate of the cost from a state to the
+// nearest goal. The heuristic must never overestimate and must be consistent (it may drop
+// by at most the cost of a move), otherwise costs and predecessors may not be the
+// cheapest. A nil heuristic makes AStar the same as Dijkstra.
+func AStar[S comparable](start S, neighbors func(S) iter.Seq2[S, int], heuristic func(S) int,
+	goal func(S) bool) *Result[S] {
+	estimate := func(S) int { return 0 }
+	if heuristic != nil {
+		estimate = heuristic
+	}
+
+	// Entries are never updated in place: a state whose cost drops is queued again, and
+	// the entries left behind at its old cost are skipped when they come out
+	type entry struct {
+		state          S
+		cost, priority int
+	}
+	r := newResult[S]()
+	r.Dist[start] = 0
+	open := container.NewPriorityQueue(func(a, b entry) bool { return a.priority < b.priority })
+	open.Push(entry{start, 0, estimate(start)})
+	best := -1
+	for open.Len() > 0 {
+		e, _ := open.Pop()
+		s, cost := e.state, e.cost
+		if cost != r.Dist[s] {
+			continue
+		}
+		if best != -1 && e.priority > best {
+			break
+		}
+		if goal != nil && goal(s) {
+			best = cost
+			r.Goals = append(r.Goals, s)
+			continue
+		}
+		for next, step := range neighbors(s) {
+			c := cost + step
+			nc, seen := r.Dist[next]
+			switch {
+			case !seen || c < nc:
+				r.Dist[next] = c
+				r.Prev[next] = []S{s}
+				open.Push(entry{next, c, c + estimate(next)})
+			case c == nc:
+				r.Prev[next] = append(r.Prev[next], s)
+			}
+		}
+	}
+	return r
+}
@@ -0,0 +1,124 @@
+package search
+
+import (
+	"iter"
+	"reflect"
+	"testing"
+
+	"aoc2024/utility/container"
+	"aoc2024/utility/geom"
+	"aoc2024/utility/grid"
+)
+
+// openCells returns a neighbour function over the non-wall cells of a maze
+func openCells(t *testing.T, lines []string) (*grid.Grid[byte], func(geom.Point) iter.Seq[geom.Point]) {
+	t.Helper()
+	g, err := grid.Bytes(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g, func(p geom.Point) iter.Seq[geom.Point] {
+		return func(yield func(geom.Point) bool) {
+			for q, c := range g.Neighbors4(p) {
+				if c != '#' && !yield(q) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// weighted gives every step in a maze a cost of 1, for the weighted searches
+func weighted(neighbors func(geom.Point) iter.Seq[geom.Point]) func(geom.Point) iter.Seq2[geom.Point, int] {
+	return func(p geom.Point) iter.Seq2[geom.Point, int] {
+		return func(yield func(geom.Point, int) bool) {
+			for q := range neighbors(p) {
+				if !yield(q, 1) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestShortestPaths(t *testing.T) {
+	maze := []string{
+		"S...",
+		".#..",
+		"...E",
+		"##..",
+	}
+	g, neighbors := openCells(t, maze)
+	start := geom.Point{X: 0, Y: 0}
+	end := geom.Point{X: 3, Y: 2}
+	isEnd := func(p geom.Point) bool { return p == end }
+
+	searches := []struct {
+		name   string
+		result *Result[geom.Point]
+	}{
+		{name: "BFS", result: BFS(start, neighbors, isEnd)},
+		{name: "Dijkstra", result: Dijkstra(start, weighted(neighbors), isEnd)},
+		{name: "AStar", result: AStar(start, weighted(neighbors), end.Manhattan, isEnd)},
+	}
+	for _, s := range searches {
+		r := s.result
+		if r.Cost() != 5 {
+			t.Errorf("%s: Cost() = %d, want 5", s.name, r.Cost())
+		}
+		if path := r.Path(end); len(path) != 6 || path[0] != start || path[5] != end {
+			t.Errorf("%s: Path() = %v", s.name, path)
+		}
+
+		// Every open cell except the two bottom right ones is on some shortest path
+		want := 0
+		for p, c := range g.All() {
+			if c != '#' && p.Y < 3 {
+				want++
+			}
+		}
+		if got := len(r.OnShortestPaths()); got != want {
+			t.Errorf("%s: OnShortestPaths() has %d states, want %d", s.name, got, want)
+		}
+	}
+}
+
+func TestWeighted(t *testing.T) {
+	// A direct edge from a to c costs more than going through b
+	edges := map[string]map[string]int{
+		"a": {"b": 2, "c": 5, "d": 1},
+		"b": {"c": 2},
+		"d": {"c": 3},
+	}
+	neighbors := func(s string) iter.Seq2[string, int] {
+		return func(yield func(string, int) bool) {
+			for next, cost := range edges[s] {
+				if !yield(next, cost) {
+					return
+				}
+			}
+		}
+	}
+	r := Dijkstra("a", neighbors, func(s string) bool { return s == "c" })
+	if r.Cost() != 4 {
+		t.Errorf("Cost() = %d, want 4", r.Cost())
+	}
+	want := container.NewSet("a", "b", "c", "d")
+	if got := r.OnShortestPaths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("OnShortestPaths() = %v, want %v", got, want)
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	_, neighbors := openCells(t, []string{"S#E"})
+	end := geom.Point{X: 2, Y: 0}
+	r := BFS(geom.Point{}, neighbors, func(p geom.Point) bool { return p == end })
+	if r.Found() || r.Cost() != -1 || r.Path(end) != nil || len(r.OnShortestPaths()) != 0 {
+		t.Errorf("BFS() reached a walled-off goal: cost %d, path %v", r.Cost(), r.Path(end))
+	}
+
+	// Without a goal every reachable state is visited
+	if r := BFS(geom.Point{}, neighbors, nil); len(r.Dist) != 1 {
+		t.Errorf("BFS() visited %d states, want 1", len(r.Dist))
+	}
+}
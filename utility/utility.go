@@ -1,51 +1,35 @@
 package utility
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"strconv"
 )
 
+// ErrInvalidNumber is returned when an input value cannot be parsed as an integer
+var ErrInvalidNumber = errors.New("invalid number")
+
+// ParseTextFile reads <filename>.txt from the current working directory
 func ParseTextFile(filename string) ([]string, error) {
 	pathToWorkingDir, err := os.Getwd()
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	inputPath := filepath.Join(pathToWorkingDir, fmt.Sprintf("%s.txt", filename))
-	file, err := os.Open(inputPath)
-	if err != nil {
-		return nil, err
-	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			log.Fatal(err)
-		}
-	}(file)
-
-	var lines []string
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
-	}
-	if err := scanner.Err(); err != nil {
 		return nil, err
 	}
-	return lines, nil
+	return ReadFile(filepath.Join(pathToWorkingDir, fmt.Sprintf("%s.txt", filename)))
 }
 
-func SliceOfStringsToInt(input []string) []int {
+// SliceOfStringsToInt converts each string to an integer.
+// Returns ErrInvalidNumber for the first string that is not an integer.
+func SliceOfStringsToInt(input []string) ([]int, error) {
 	result := make([]int, 0, len(input))
 	for _, line := range input {
 		i, err := strconv.Atoi(line)
 		if err != nil {
-			log.Fatal(err)
+			return nil, fmt.Errorf("%w: %q", ErrInvalidNumber, line)
 		}
 		result = append(result, i)
 	}
-	return result
+	return result, nil
 }